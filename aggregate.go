@@ -0,0 +1,328 @@
+package linkedlist
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AggFunc identifies a built-in aggregate, as used by Resample.
+type AggFunc int
+
+const (
+	AggCount   AggFunc = iota // number of non-NULL values, int64
+	AggSum                    // int64 for integers, float64 otherwise; int64 overflow is an error
+	AggAvg                    // float64
+	AggMin                    // smallest value by compareValues order
+	AggMax                    // largest value by compareValues order
+	AggCollect                // []interface{} of the non-NULL values in row order
+	AggConcat                 // string of the non-NULL values joined with ","
+)
+
+// aggSpec is one aggregate column of an Aggregation.
+type aggSpec struct {
+	fn     AggFunc
+	column string
+	name   string
+	sep    string
+}
+
+// Aggregation builds an in-memory GROUP BY over a list. Create one with
+// LinkedList.Aggregate, chain the grouping and aggregates, then call Run.
+type Aggregation struct {
+	ll      *LinkedList
+	groupBy []string
+	aggs    []aggSpec
+	having  string
+}
+
+// Aggregate starts an aggregation over the list.
+func (ll *LinkedList) Aggregate() *Aggregation {
+	return &Aggregation{ll: ll}
+}
+
+// GroupBy sets the columns rows are grouped by. Without it all rows form a
+// single group.
+func (a *Aggregation) GroupBy(columns ...string) *Aggregation {
+	a.groupBy = append(a.groupBy, columns...)
+	return a
+}
+
+// Count adds a "count" column holding the number of rows in each group.
+func (a *Aggregation) Count() *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggCount, name: "count"})
+	return a
+}
+
+// Sum adds a "sum_<column>" column. NULL values are ignored.
+func (a *Aggregation) Sum(column string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggSum, column: column, name: "sum_" + column})
+	return a
+}
+
+// Avg adds an "avg_<column>" column. NULL values are ignored.
+func (a *Aggregation) Avg(column string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggAvg, column: column, name: "avg_" + column})
+	return a
+}
+
+// Min adds a "min_<column>" column. NULL values are ignored.
+func (a *Aggregation) Min(column string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggMin, column: column, name: "min_" + column})
+	return a
+}
+
+// Max adds a "max_<column>" column. NULL values are ignored.
+func (a *Aggregation) Max(column string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggMax, column: column, name: "max_" + column})
+	return a
+}
+
+// Collect adds a "collect_<column>" column holding the group's values as a
+// []interface{}, in row order. NULL values are ignored.
+func (a *Aggregation) Collect(column string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggCollect, column: column, name: "collect_" + column})
+	return a
+}
+
+// GroupConcat adds a "group_concat_<column>" column joining the text of the
+// group's values with sep, in row order, like MySQL's GROUP_CONCAT. NULL
+// values are ignored. An empty sep means ",".
+func (a *Aggregation) GroupConcat(column, sep string) *Aggregation {
+	a.aggs = append(a.aggs, aggSpec{fn: AggConcat, column: column, name: "group_concat_" + column, sep: sep})
+	return a
+}
+
+// Having filters the aggregated rows with a condition in the syntax of
+// LinkedList.Where, e.g. "count > 1 AND sum_amount >= 100".
+func (a *Aggregation) Having(condition string) *Aggregation {
+	a.having = condition
+	return a
+}
+
+// aggState accumulates one aggregate for one group.
+type aggState struct {
+	count   int64
+	sumInt  int64
+	sumF    float64
+	isFloat bool
+	best    interface{}
+	values  []interface{}
+	sep     string
+}
+
+// group accumulates the rows sharing one group key.
+type group struct {
+	keys   map[string]interface{}
+	count  int64
+	states []aggState
+}
+
+// Run evaluates the aggregation and returns one row per group, in order of
+// first appearance, holding the group columns and the aggregate columns.
+// Without GroupBy an empty list still gives one row, with a zero count and
+// NULL for the other aggregates. An integer sum outside the int64 range is
+// an error.
+func (a *Aggregation) Run() (*LinkedList, error) {
+	var order []*group
+	groups := make(map[string]*group)
+
+	err := a.ll.each(func(n *Node) error {
+		values := make([]interface{}, len(a.groupBy))
+		for i, col := range a.groupBy {
+			values[i], _ = lookupColumn(n.Data, col)
+		}
+		key := encodeKey(values)
+
+		g, ok := groups[key]
+		if !ok {
+			g = &group{keys: make(map[string]interface{}), states: make([]aggState, len(a.aggs))}
+			for i, col := range a.groupBy {
+				g.keys[col] = values[i]
+			}
+			for i, spec := range a.aggs {
+				g.states[i].sep = spec.sep
+			}
+			groups[key] = g
+			order = append(order, g)
+		}
+		g.count++
+
+		for i, spec := range a.aggs {
+			if spec.fn == AggCount {
+				continue
+			}
+			v, _ := lookupColumn(n.Data, spec.column)
+			if err := g.states[i].add(spec.fn, v); err != nil {
+				return fmt.Errorf("error aggregating %s: %w", spec.column, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Without GroupBy there is always one group, as in SQL, even over no rows.
+	if len(a.groupBy) == 0 && len(order) == 0 {
+		g := &group{keys: map[string]interface{}{}, states: make([]aggState, len(a.aggs))}
+		order = append(order, g)
+	}
+
+	var having expr
+	if a.having != "" {
+		var err error
+		if having, err = parseExpr(a.having); err != nil {
+			return nil, fmt.Errorf("invalid having condition %q: %w", a.having, err)
+		}
+	}
+
+	result := New()
+	for _, g := range order {
+		row := make(map[string]interface{}, len(g.keys)+len(a.aggs))
+		for k, v := range g.keys {
+			row[k] = v
+		}
+		for i, spec := range a.aggs {
+			if spec.fn == AggCount {
+				row[spec.name] = g.count
+			} else {
+				row[spec.name] = g.states[i].result(spec.fn)
+			}
+		}
+		if having != nil {
+			ok, err := evalBool(having, row)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating having condition: %w", err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		result.Append(row)
+	}
+	return result, nil
+}
+
+// add folds v into the state.
+func (s *aggState) add(fn AggFunc, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	switch fn {
+	case AggSum, AggAvg:
+		if i, ok := toInt64(v); ok && !s.isFloat {
+			sum, ok := addInt64(s.sumInt, i)
+			switch {
+			case ok:
+				s.sumInt = sum
+			case fn == AggSum:
+				return errIntegerOverflow
+			default:
+				// An average is a float anyway, so carry on in floating point.
+				s.sumF = float64(s.sumInt) + float64(i)
+				s.isFloat = true
+			}
+		} else if f, ok := toFloat64(v); ok {
+			if !s.isFloat {
+				s.sumF = float64(s.sumInt)
+				s.isFloat = true
+			}
+			s.sumF += f
+		} else {
+			return fmt.Errorf("cannot sum %T", v)
+		}
+	case AggMin, AggMax:
+		if s.best == nil {
+			s.best = v
+			break
+		}
+		c, err := compareValues(v, s.best)
+		if err != nil {
+			return err
+		}
+		if fn == AggMin && c < 0 || fn == AggMax && c > 0 {
+			s.best = v
+		}
+	case AggCollect, AggConcat:
+		s.values = append(s.values, v)
+	}
+	s.count++
+	return nil
+}
+
+// result returns the final aggregate value, or nil for groups with no
+// non-NULL values.
+func (s *aggState) result(fn AggFunc) interface{} {
+	if s.count == 0 {
+		return nil
+	}
+	switch fn {
+	case AggSum:
+		if s.isFloat {
+			return s.sumF
+		}
+		return s.sumInt
+	case AggAvg:
+		if s.isFloat {
+			return s.sumF / float64(s.count)
+		}
+		return float64(s.sumInt) / float64(s.count)
+	case AggCollect:
+		return s.values
+	case AggConcat:
+		texts := make([]string, len(s.values))
+		for i, v := range s.values {
+			texts[i] = valueText(v)
+		}
+		sep := s.sep
+		if sep == "" {
+			sep = ","
+		}
+		return strings.Join(texts, sep)
+	}
+	return s.best
+}
+
+// encodeKey encodes values into a string usable as a map key. Numbers are
+// encoded by value so int(1) and int64(1) produce the same key. Each part is
+// prefixed with its length, so no choice of values makes two different
+// tuples collide.
+func encodeKey(values []interface{}) string {
+	var sb strings.Builder
+	for _, v := range values {
+		part := keyPart(v)
+		sb.WriteString(strconv.Itoa(len(part)))
+		sb.WriteByte(':')
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
+
+// keyPart encodes one value of a key, tagged with its kind.
+func keyPart(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "n"
+	case string:
+		return "s" + x
+	case []byte:
+		return "s" + string(x)
+	case time.Time:
+		return "t" + x.UTC().Format(time.RFC3339Nano)
+	case uint64:
+		if x > math.MaxInt64 {
+			return "i" + strconv.FormatUint(x, 10)
+		}
+	}
+	if n, ok := toInt64(v); ok {
+		return "i" + strconv.FormatInt(n, 10)
+	} else if f, ok := toFloat64(v); ok && f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+		return "i" + strconv.FormatInt(int64(f), 10)
+	} else if ok {
+		return "f" + strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return fmt.Sprintf("%T:%v", v, v)
+}
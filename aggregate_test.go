@@ -0,0 +1,157 @@
+package linkedlist
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func salesList() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"region": "EU", "amount": int64(10)})
+	ll.Append(map[string]interface{}{"region": "US", "amount": 5})
+	ll.Append(map[string]interface{}{"region": "EU", "amount": 2.5})
+	ll.Append(map[string]interface{}{"region": "US", "amount": nil})
+	ll.Append(map[string]interface{}{"region": "APAC", "amount": int64(7)})
+	return ll
+}
+
+func TestAggregate_GroupBySumCount(t *testing.T) {
+	got, err := salesList().Aggregate().GroupBy("region").Sum("amount").Count().Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Len() != 3 {
+		t.Fatalf("Expected 3 groups, got %d", got.Len())
+	}
+
+	eu := got.First().Data
+	if eu["region"] != "EU" || eu["sum_amount"] != 12.5 || eu["count"] != int64(2) {
+		t.Errorf("Unexpected EU row: %+v", eu)
+	}
+	us := got.First().next.Data
+	if us["sum_amount"] != int64(5) || us["count"] != int64(2) {
+		t.Errorf("Unexpected US row: %+v", us)
+	}
+}
+
+func TestAggregate_AvgMinMaxHaving(t *testing.T) {
+	got, err := salesList().Aggregate().
+		GroupBy("region").
+		Avg("amount").Min("amount").Max("amount").Count().
+		Having("count > 1").
+		Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Len() != 2 {
+		t.Fatalf("Expected 2 groups after Having, got %d", got.Len())
+	}
+	eu := got.First().Data
+	if eu["avg_amount"] != 6.25 || eu["min_amount"] != 2.5 || eu["max_amount"] != int64(10) {
+		t.Errorf("Unexpected EU row: %+v", eu)
+	}
+}
+
+func TestAggregate_NoGroupBy(t *testing.T) {
+	got, err := salesList().Aggregate().Count().Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Len() != 1 || got.First().Data["count"] != int64(5) {
+		t.Errorf("Expected single row with count 5, got %+v", got.First())
+	}
+}
+
+func TestAggregate_NoGroupByEmpty(t *testing.T) {
+	got, err := New().Aggregate().Count().Sum("amount").Max("amount").Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Len() != 1 {
+		t.Fatalf("Expected a single row, got %d", got.Len())
+	}
+	row := got.First().Data
+	if row["count"] != int64(0) || row["sum_amount"] != nil || row["max_amount"] != nil {
+		t.Errorf("Unexpected row: %+v", row)
+	}
+
+	got, err = New().Aggregate().GroupBy("region").Count().Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got.Len() != 0 {
+		t.Errorf("Expected no groups, got %d", got.Len())
+	}
+}
+
+func TestAggregate_SumOverflow(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"amount": int64(math.MaxInt64)})
+	ll.Append(map[string]interface{}{"amount": int64(2)})
+	if _, err := ll.Aggregate().Sum("amount").Run(); !errors.Is(err, errIntegerOverflow) {
+		t.Errorf("Expected overflow error, got %v", err)
+	}
+	got, err := ll.Aggregate().Avg("amount").Run()
+	if err != nil {
+		t.Fatalf("Avg failed: %v", err)
+	}
+	if avg := got.First().Data["avg_amount"].(float64); avg < math.MaxInt64/2 {
+		t.Errorf("Unexpected average %v", avg)
+	}
+}
+
+func TestAggregate_Errors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"amount": "ten"})
+	if _, err := ll.Aggregate().Sum("amount").Run(); err == nil {
+		t.Error("Expected error summing strings")
+	}
+	if _, err := ll.Aggregate().Count().Having("count >").Run(); err == nil {
+		t.Error("Expected error for invalid having condition")
+	}
+}
+
+func TestAggregate_CollectGroupConcat(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"order_id": 1, "item_name": "pen"})
+	ll.Append(map[string]interface{}{"order_id": 2, "item_name": "ink"})
+	ll.Append(map[string]interface{}{"order_id": 1, "item_name": nil})
+	ll.Append(map[string]interface{}{"order_id": 1, "item_name": "pad"})
+	ll.Append(map[string]interface{}{"order_id": 3, "item_name": nil})
+
+	got, err := ll.Aggregate().GroupBy("order_id").
+		Collect("item_name").GroupConcat("item_name", "; ").GroupConcat("order_id", "").
+		Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	first := got.First().Data
+	if items, ok := first["collect_item_name"].([]interface{}); !ok || len(items) != 2 || items[0] != "pen" || items[1] != "pad" {
+		t.Errorf("Expected [pen pad], got %v", first["collect_item_name"])
+	}
+	if first["group_concat_item_name"] != "pen; pad" || first["group_concat_order_id"] != "1,1,1" {
+		t.Errorf("Unexpected concatenations: %+v", first)
+	}
+	last := got.Last().Data
+	if last["collect_item_name"] != nil || last["group_concat_item_name"] != nil {
+		t.Errorf("Expected NULL for a group without values, got %+v", last)
+	}
+}
+
+func TestAggregate_GroupByLargeUnsigned(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"k": uint64(math.MaxUint64)})
+	ll.Append(map[string]interface{}{"k": uint64(math.MaxUint64 - 1)})
+	ll.Append(map[string]interface{}{"k": uint64(1)})
+	ll.Append(map[string]interface{}{"k": int64(1)})
+
+	got, err := ll.Aggregate().GroupBy("k").Count().Run()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if counts := columnValues(got, "count"); !reflect.DeepEqual(counts, []interface{}{int64(1), int64(1), int64(2)}) {
+		t.Errorf("Expected groups of 1, 1 and 2, got %v", counts)
+	}
+}
@@ -0,0 +1,73 @@
+package linkedlist
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+)
+
+// AsOfJoin returns a new list with one row per row of left, in order,
+// extended with the columns of the latest right row whose timeCol is not
+// after the left row's. Columns present on both sides keep the left value.
+// Left rows without a match, because no right row is early enough or the
+// closest is more than tolerance older, are kept unextended; a non-positive
+// tolerance matches regardless of age. Right rows with a NULL time are
+// ignored. Times may be time.Time values or RFC 3339 strings.
+func AsOfJoin(left, right *LinkedList, timeCol string, tolerance time.Duration) (*LinkedList, error) {
+	type timed struct {
+		at time.Time
+		n  *Node
+	}
+	var refs []timed
+	pos := 0
+	err := right.each(func(n *Node) error {
+		pos++
+		raw, _ := lookupColumn(n.Data, timeCol)
+		if raw == nil {
+			return nil
+		}
+		t, ok := (*scanner)(nil).scanTime(raw)
+		if !ok {
+			return fmt.Errorf("right row %d: %s value %v is not a time", pos, timeCol, raw)
+		}
+		refs = append(refs, timed{at: t, n: n})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Stable, so among equal times the last right row wins.
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].at.Before(refs[j].at) })
+
+	result := New()
+	pos = 0
+	err = left.each(func(n *Node) error {
+		pos++
+		row := maps.Clone(n.Data)
+		if row == nil {
+			row = make(map[string]interface{})
+		}
+		if raw, _ := lookupColumn(n.Data, timeCol); raw != nil {
+			t, ok := (*scanner)(nil).scanTime(raw)
+			if !ok {
+				return fmt.Errorf("left row %d: %s value %v is not a time", pos, timeCol, raw)
+			}
+			// First reference strictly after t; the one before it matches.
+			i := sort.Search(len(refs), func(i int) bool { return refs[i].at.After(t) })
+			if i > 0 && (tolerance <= 0 || t.Sub(refs[i-1].at) <= tolerance) {
+				for k, v := range refs[i-1].n.Data {
+					if _, ok := row[k]; !ok {
+						row[k] = v
+					}
+				}
+			}
+		}
+		result.appendNode(n.derive(row))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
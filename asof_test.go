@@ -0,0 +1,61 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAsOfJoin(t *testing.T) {
+	base := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	trades := New()
+	trades.Append(map[string]interface{}{"at": base.Add(-time.Minute), "id": 1})
+	trades.Append(map[string]interface{}{"at": base.Add(30 * time.Second), "id": 2})
+	trades.Append(map[string]interface{}{"at": base.Add(2 * time.Minute), "id": 3})
+	trades.Append(map[string]interface{}{"at": base.Add(time.Hour), "id": 4})
+	trades.Append(map[string]interface{}{"at": nil, "id": 5})
+
+	quotes := New()
+	quotes.Append(map[string]interface{}{"at": base.Add(2 * time.Minute), "bid": 101.0, "id": 99})
+	quotes.Append(map[string]interface{}{"at": base, "bid": 100.0})
+	quotes.Append(map[string]interface{}{"at": base.Add(2 * time.Minute).Format(time.RFC3339), "bid": 102.0})
+
+	out, err := AsOfJoin(trades, quotes, "at", 10*time.Minute)
+	if err != nil {
+		t.Fatalf("AsOfJoin failed: %v", err)
+	}
+	if out.Len() != 5 {
+		t.Fatalf("Expected 5 rows, got %d", out.Len())
+	}
+	want := map[int]interface{}{1: nil, 2: 100.0, 3: 102.0, 4: nil, 5: nil}
+	for n := out.First(); n != nil; n = n.next {
+		id := n.Data["id"].(int)
+		if n.Data["bid"] != want[id] {
+			t.Errorf("Expected bid %v for trade %d, got %v", want[id], id, n.Data["bid"])
+		}
+	}
+	if trades.First().Data["bid"] != nil {
+		t.Error("Expected left list unchanged")
+	}
+
+	unlimited, err := AsOfJoin(trades, quotes, "at", 0)
+	if err != nil {
+		t.Fatalf("AsOfJoin failed: %v", err)
+	}
+	var fourth *Node
+	for n := unlimited.First(); n != nil; n = n.next {
+		if n.Data["id"] == 4 {
+			fourth = n
+		}
+	}
+	if fourth.Data["bid"] != 102.0 {
+		t.Errorf("Expected unlimited tolerance to match bid 102, got %v", fourth.Data["bid"])
+	}
+}
+
+func TestAsOfJoinInvalidTime(t *testing.T) {
+	left := New()
+	left.Append(map[string]interface{}{"at": 12})
+	if _, err := AsOfJoin(left, New(), "at", 0); err == nil {
+		t.Error("Expected error for non-time value, got nil")
+	}
+}
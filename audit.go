@@ -0,0 +1,62 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AuditEntry describes one operation performed on a list.
+type AuditEntry struct {
+	Time   time.Time
+	Op     string // "load", "filter", "update" or "export"
+	Detail string // operation name and arguments, e.g. "Where age > 30"
+	Rows   int    // rows loaded, kept, touched or exported
+}
+
+// EnableAudit starts recording loads, filters, updates and exports in the
+// list's audit trail. Lists derived with Filter, Where and Select inherit the
+// trail so far, giving each result its own lineage. Entries are stamped with
+// the list's clock when it has one.
+func (ll *LinkedList) EnableAudit() {
+	if ll == nil {
+		return
+	}
+	ll.auditOn = true
+}
+
+// AuditTrail returns a copy of the recorded operations, oldest first.
+func (ll *LinkedList) AuditTrail() []AuditEntry {
+	if ll == nil || len(ll.audit) == 0 {
+		return nil
+	}
+	return append([]AuditEntry(nil), ll.audit...)
+}
+
+// record appends an entry to the audit trail when auditing is enabled.
+func (ll *LinkedList) record(op string, rows int, format string, args ...interface{}) {
+	if ll == nil || !ll.auditOn {
+		return
+	}
+	now := time.Now()
+	if ll.clock != nil {
+		now = ll.clock.Now()
+	}
+	ll.audit = append(ll.audit, AuditEntry{Time: now, Op: op, Detail: fmt.Sprintf(format, args...), Rows: rows})
+}
+
+// recordDerived records a filter on ll and hands its trail on to result.
+func (ll *LinkedList) recordDerived(result *LinkedList, format string, args ...interface{}) {
+	if ll == nil || !ll.auditOn {
+		return
+	}
+	ll.record("filter", result.Len(), format, args...)
+	result.auditOn = true
+	result.clock = ll.clock
+	result.audit = append([]AuditEntry(nil), ll.audit...)
+}
+
+// auditColumns formats a column list for an audit detail.
+func auditColumns(columns []string) string {
+	return strings.Join(columns, ", ")
+}
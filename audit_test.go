@@ -0,0 +1,64 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAudit_RecordsOperations(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+	ll := New(WithTimestamps(clock))
+	ll.EnableAudit()
+	if err := ll.LoadFromSQLxWithOptions(queryRows(t, []string{"name", "age"}, []interface{}{"Alice", 34}, []interface{}{"Bob", 28}), LoadOptions{Source: "people"}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := ll.Redact([]string{"name"}, RedactMask); err != nil {
+		t.Fatalf("Redact failed: %v", err)
+	}
+	older, err := ll.Where("age > 30")
+	if err != nil {
+		t.Fatalf("Where failed: %v", err)
+	}
+	var out []struct{ Age int }
+	if err := older.ToSlice(&out); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+
+	trail := ll.AuditTrail()
+	wantOps := []string{"load", "update", "filter"}
+	if len(trail) != len(wantOps) {
+		t.Fatalf("Expected %d source entries, got %+v", len(wantOps), trail)
+	}
+	for i, op := range wantOps {
+		if trail[i].Op != op {
+			t.Errorf("Expected entry %d to be %s, got %s", i, op, trail[i].Op)
+		}
+	}
+	if trail[0].Rows != 2 || trail[0].Detail != `LoadFromSQLx source="people"` {
+		t.Errorf("Unexpected load entry: %+v", trail[0])
+	}
+	if trail[2].Rows != 1 || trail[2].Detail != "Where age > 30" {
+		t.Errorf("Unexpected filter entry: %+v", trail[2])
+	}
+	if !trail[0].Time.Equal(clock.now) {
+		t.Errorf("Expected entries stamped by the list clock, got %v", trail[0].Time)
+	}
+
+	lineage := older.AuditTrail()
+	if len(lineage) != 4 || lineage[3].Op != "export" || lineage[3].Rows != 1 {
+		t.Errorf("Expected derived list to inherit trail and record export, got %+v", lineage)
+	}
+}
+
+func TestAudit_DisabledByDefault(t *testing.T) {
+	ll := peopleList()
+	ll.Select("name")
+	if trail := ll.AuditTrail(); trail != nil {
+		t.Errorf("Expected no audit trail, got %+v", trail)
+	}
+	var nilList *LinkedList
+	nilList.EnableAudit()
+	if nilList.AuditTrail() != nil {
+		t.Error("Expected nil list to have no trail")
+	}
+}
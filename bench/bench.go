@@ -0,0 +1,148 @@
+// Package bench provides load generators and benchmark helpers for measuring
+// linked list workloads, so applications can track StructScan, sorting and
+// filtering performance on data shaped like their own.
+package bench
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// Row matches the first five generated columns and is a convenient
+// StructScan/ToSlice destination.
+type Row struct {
+	ID      int64     `db:"c0"`
+	Score   float64   `db:"c1"`
+	Name    string    `db:"c2"`
+	Active  bool      `db:"c3"`
+	Created time.Time `db:"c4"`
+}
+
+// Config describes a generated data set.
+type Config struct {
+	// Rows is the number of rows to generate.
+	Rows int
+	// Columns is the number of columns per row, named c0, c1, ... Column
+	// types cycle through int64, float64, string, bool and time.Time.
+	Columns int
+	// NullRate is the fraction (0..1) of non-key values that are NULL.
+	NullRate float64
+	// Seed makes generation deterministic.
+	Seed int64
+}
+
+// base is the fixed origin for generated times.
+var base = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Generate returns cfg.Rows rows of mixed-type data. Column c0 holds a
+// unique int64 key.
+func Generate(cfg Config) []map[string]interface{} {
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	rows := make([]map[string]interface{}, cfg.Rows)
+	for i := range rows {
+		row := make(map[string]interface{}, cfg.Columns)
+		for c := 0; c < cfg.Columns; c++ {
+			name := fmt.Sprintf("c%d", c)
+			if c > 0 && rng.Float64() < cfg.NullRate {
+				row[name] = nil
+				continue
+			}
+			switch c % 5 {
+			case 0:
+				if c == 0 {
+					row[name] = int64(i)
+				} else {
+					row[name] = rng.Int63n(1 << 20)
+				}
+			case 1:
+				row[name] = rng.Float64() * 1000
+			case 2:
+				row[name] = fmt.Sprintf("name-%06d", rng.Intn(1000000))
+			case 3:
+				row[name] = rng.Intn(2) == 1
+			case 4:
+				row[name] = base.Add(time.Duration(rng.Int63n(int64(365 * 24 * time.Hour))))
+			}
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// NewList returns a list holding Generate(cfg).
+func NewList(cfg Config) *linkedlist.LinkedList {
+	ll := linkedlist.New()
+	for _, row := range Generate(cfg) {
+		ll.Append(row)
+	}
+	return ll
+}
+
+// Append measures appending cfg.Rows pre-generated rows to a new list.
+func Append(b *testing.B, cfg Config) {
+	rows := Generate(cfg)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ll := linkedlist.New()
+		for _, row := range rows {
+			ll.Append(row)
+		}
+	}
+}
+
+// StructScan measures scanning every node of the list into dest, which must
+// be a pointer to a struct.
+func StructScan(b *testing.B, ll *linkedlist.LinkedList, dest interface{}) {
+	nodes := ll.Nodes()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, n := range nodes {
+			if err := n.StructScan(dest); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// ToSlice measures converting the list into a new slice on each iteration.
+// newDest must return a fresh pointer to a slice.
+func ToSlice(b *testing.B, ll *linkedlist.LinkedList, newDest func() interface{}) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := ll.ToSlice(newDest()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// OrderBy measures sorting a freshly generated list by spec. List
+// generation is excluded from the timing.
+func OrderBy(b *testing.B, cfg Config, spec string) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ll := NewList(cfg)
+		b.StartTimer()
+		if err := ll.OrderBy(spec); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Where measures filtering the list with condition.
+func Where(b *testing.B, ll *linkedlist.LinkedList, condition string) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ll.Where(condition); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
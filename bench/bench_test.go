@@ -0,0 +1,52 @@
+package bench
+
+import (
+	"testing"
+	"time"
+)
+
+var small = Config{Rows: 1000, Columns: 10, NullRate: 0.1, Seed: 1}
+
+func TestGenerate(t *testing.T) {
+	rows := Generate(Config{Rows: 3, Columns: 6, Seed: 7})
+	if len(rows) != 3 || len(rows[0]) != 6 {
+		t.Fatalf("Unexpected shape: %d rows", len(rows))
+	}
+	if rows[2]["c0"] != int64(2) {
+		t.Errorf("Expected sequential key, got %v", rows[2]["c0"])
+	}
+	if _, ok := rows[0]["c4"].(time.Time); !ok {
+		t.Errorf("Expected time in c4, got %T", rows[0]["c4"])
+	}
+
+	again := Generate(Config{Rows: 3, Columns: 6, Seed: 7})
+	if rows[1]["c2"] != again[1]["c2"] {
+		t.Error("Expected generation to be deterministic for a seed")
+	}
+
+	var out []Row
+	if err := NewList(Config{Rows: 5, Columns: 5, Seed: 1}).ToSlice(&out); err != nil || len(out) != 5 {
+		t.Errorf("Expected 5 scanned rows, got %d (err=%v)", len(out), err)
+	}
+}
+
+func BenchmarkAppend(b *testing.B) {
+	Append(b, small)
+}
+
+func BenchmarkStructScan(b *testing.B) {
+	var r Row
+	StructScan(b, NewList(Config{Rows: 1000, Columns: 5, Seed: 1}), &r)
+}
+
+func BenchmarkToSlice(b *testing.B) {
+	ToSlice(b, NewList(Config{Rows: 1000, Columns: 5, Seed: 1}), func() interface{} { return &[]Row{} })
+}
+
+func BenchmarkOrderBy(b *testing.B) {
+	OrderBy(b, small, "c2 ASC, c1 DESC")
+}
+
+func BenchmarkWhere(b *testing.B) {
+	Where(b, NewList(small), "c1 > 500 AND c3 = TRUE")
+}
@@ -0,0 +1,287 @@
+package linkedlist
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+)
+
+// decimalTypes holds the types, by package path and name, whose String
+// method bigText trusts to return a decimal number. See RegisterDecimalType.
+var decimalTypes = struct {
+	sync.RWMutex
+	m map[string]bool
+}{m: map[string]bool{
+	"github.com/shopspring/decimal.Decimal": true,
+	"github.com/cockroachdb/apd.Decimal":    true,
+	"github.com/cockroachdb/apd/v2.Decimal": true,
+	"github.com/cockroachdb/apd/v3.Decimal": true,
+	"github.com/ericlagergren/decimal.Big":  true,
+	"github.com/govalues/decimal.Decimal":   true,
+}}
+
+// RegisterDecimalType marks the type of v, a value or pointer, as a decimal
+// number type whose String method returns plain decimal text such as
+// "-12.50", so Dialect.Literal writes its values as numbers. The decimal
+// types of shopspring/decimal, cockroachdb/apd, ericlagergren/decimal and
+// govalues/decimal are registered already. Other Stringers are not taken
+// for numbers, however their text looks.
+func RegisterDecimalType(v interface{}) {
+	decimalTypes.Lock()
+	defer decimalTypes.Unlock()
+	decimalTypes.m[decimalTypeName(reflect.TypeOf(v))] = true
+}
+
+// isDecimalType reports whether t was registered as a decimal type.
+func isDecimalType(t reflect.Type) bool {
+	decimalTypes.RLock()
+	defer decimalTypes.RUnlock()
+	return decimalTypes.m[decimalTypeName(t)]
+}
+
+// decimalTypeName names t, or the type it points to, by package path and
+// name.
+func decimalTypeName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+// ratDigits is the number of decimal places a big.Rat without a finite
+// decimal expansion is rounded to on export.
+const ratDigits = 30
+
+// setBigNumber sets big.Int, big.Float and big.Rat fields, or pointers to
+// them, without going through float64. NUMERIC and DECIMAL columns arrive
+// as strings and are parsed exactly. It reports false for other fields.
+func setBigNumber(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (bool, error) {
+	target := fieldType
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+	if target != bigIntType && target != bigFloatType && target != bigRatType {
+		return false, nil
+	}
+
+	var v reflect.Value
+	switch target {
+	case bigIntType:
+		i, err := toBigInt(dataValue)
+		if err != nil {
+			return true, err
+		}
+		v = reflect.ValueOf(i)
+	case bigFloatType:
+		f, err := toBigFloat(dataValue)
+		if err != nil {
+			return true, err
+		}
+		v = reflect.ValueOf(f)
+	default:
+		r, err := toBigRat(dataValue)
+		if err != nil {
+			return true, err
+		}
+		v = reflect.ValueOf(r)
+	}
+	if fieldType.Kind() == reflect.Ptr {
+		field.Set(v)
+	} else {
+		field.Set(v.Elem())
+	}
+	return true, nil
+}
+
+// toBigRat converts a numeric value or decimal text to an exact big.Rat.
+func toBigRat(v interface{}) (*big.Rat, error) {
+	switch x := v.(type) {
+	case *big.Rat:
+		return new(big.Rat).Set(x), nil
+	case *big.Int:
+		return new(big.Rat).SetInt(x), nil
+	case *big.Float:
+		r, _ := x.Rat(nil)
+		if r == nil {
+			return nil, fmt.Errorf("%v is not a rational number", x)
+		}
+		return r, nil
+	case string, []byte:
+		s := strings.TrimSpace(valueText(x))
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return nil, fmt.Errorf("cannot parse %q as a number", s)
+		}
+		return r, nil
+	}
+	if i, ok := toInt64(v); ok {
+		return new(big.Rat).SetInt64(i), nil
+	}
+	if u, ok := v.(uint64); ok {
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(u)), nil
+	}
+	if f, ok := toFloat64(v); ok {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%v is not a rational number", f)
+		}
+		return new(big.Rat).SetFloat64(f), nil
+	}
+	return nil, fmt.Errorf("cannot convert %T to a number", v)
+}
+
+// toBigInt converts v to a big.Int, failing if v has a fraction.
+func toBigInt(v interface{}) (*big.Int, error) {
+	if x, ok := v.(*big.Int); ok {
+		return new(big.Int).Set(x), nil
+	}
+	r, err := toBigRat(v)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%v is not an integer", v)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// toBigFloat converts v to a big.Float. Decimal text is parsed with enough
+// precision to hold every digit it has.
+func toBigFloat(v interface{}) (*big.Float, error) {
+	switch x := v.(type) {
+	case *big.Float:
+		return new(big.Float).Copy(x), nil
+	case float64:
+		return big.NewFloat(x), nil
+	case string, []byte:
+		s := strings.TrimSpace(valueText(x))
+		prec := uint(len(s)) * 4
+		if prec < 64 {
+			prec = 64
+		}
+		f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as a number: %w", s, err)
+		}
+		return f, nil
+	}
+	r, err := toBigRat(v)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Float).SetPrec(256).SetRat(r), nil
+}
+
+// unmarshalText sets fields whose pointer implements
+// encoding.TextUnmarshaler from string or []byte values. Decimal types such
+// as shopspring's decimal.Decimal are scanned this way without a converter.
+func unmarshalText(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (bool, error) {
+	switch dataValue.(type) {
+	case string, []byte:
+	default:
+		return false, nil
+	}
+	target := fieldType
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+	u, ok := reflect.New(target).Interface().(encoding.TextUnmarshaler)
+	if !ok {
+		return false, nil
+	}
+	if err := u.UnmarshalText([]byte(valueText(dataValue))); err != nil {
+		return true, err
+	}
+	if fieldType.Kind() == reflect.Ptr {
+		field.Set(reflect.ValueOf(u))
+	} else {
+		field.Set(reflect.ValueOf(u).Elem())
+	}
+	return true, nil
+}
+
+// bigText returns the exact decimal text of big.Int, big.Float and big.Rat
+// values, and of registered decimal types whose String method returns a
+// plain decimal number. It reports false for other values.
+func bigText(v interface{}) (string, bool) {
+	switch x := v.(type) {
+	case *big.Int:
+		if x != nil {
+			return x.String(), true
+		}
+	case big.Int:
+		return x.String(), true
+	case *big.Float:
+		if x != nil && !x.IsInf() {
+			return x.Text('g', -1), true
+		}
+	case big.Float:
+		return bigText(&x)
+	case *big.Rat:
+		if x != nil {
+			return ratText(x), true
+		}
+	case big.Rat:
+		return ratText(&x), true
+	case fmt.Stringer:
+		if !isDecimalType(reflect.TypeOf(x)) {
+			break
+		}
+		if s := x.String(); isDecimalText(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// ratText formats r as a decimal, exactly when its denominator has only the
+// factors 2 and 5 and rounded to ratDigits places otherwise.
+func ratText(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	d := new(big.Int).Set(r.Denom())
+	twos, fives := 0, 0
+	two, five, rem := big.NewInt(2), big.NewInt(5), new(big.Int)
+	for {
+		if q, m := new(big.Int).QuoRem(d, two, rem); m.Sign() == 0 {
+			d, twos = q, twos+1
+			continue
+		}
+		if q, m := new(big.Int).QuoRem(d, five, rem); m.Sign() == 0 {
+			d, fives = q, fives+1
+			continue
+		}
+		break
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return r.FloatString(ratDigits)
+	}
+	return r.FloatString(max(twos, fives))
+}
+
+// isDecimalText reports whether s is a plain decimal number such as -12.50.
+func isDecimalText(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	digits, dot := 0, false
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.' && !dot:
+			dot = true
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
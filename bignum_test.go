@@ -0,0 +1,111 @@
+package linkedlist
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// testDecimal is a minimal decimal type scanned through UnmarshalText.
+type testDecimal struct {
+	text string
+}
+
+func (d *testDecimal) UnmarshalText(b []byte) error {
+	d.text = string(b)
+	return nil
+}
+
+func (d testDecimal) String() string { return d.text }
+
+func init() {
+	RegisterDecimalType(testDecimal{})
+}
+
+func TestScanBigNumbers(t *testing.T) {
+	type row struct {
+		Count   big.Int     `db:"count"`
+		Balance *big.Rat    `db:"balance"`
+		Rate    *big.Float  `db:"rate"`
+		Price   testDecimal `db:"price"`
+	}
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"count":   "123456789012345678901234567890",
+		"balance": "1234567890123456.01",
+		"rate":    0.25,
+		"price":   "19.99",
+	})
+
+	var dest row
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if dest.Count.String() != "123456789012345678901234567890" {
+		t.Errorf("Expected exact count, got %s", dest.Count.String())
+	}
+	if dest.Balance == nil || dest.Balance.FloatString(2) != "1234567890123456.01" {
+		t.Errorf("Expected exact balance, got %v", dest.Balance)
+	}
+	if dest.Rate == nil || dest.Rate.Text('g', -1) != "0.25" {
+		t.Errorf("Expected rate 0.25, got %v", dest.Rate)
+	}
+	if dest.Price.text != "19.99" {
+		t.Errorf("Expected price 19.99, got %q", dest.Price.text)
+	}
+}
+
+func TestScanBigIntRejectsFraction(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"count": "1.5"})
+
+	var dest struct {
+		Count *big.Int `db:"count"`
+	}
+	if err := ll.ScanFirst(&dest); err == nil {
+		t.Error("Expected error scanning 1.5 into big.Int, got nil")
+	}
+}
+
+func TestExportBigNumbers(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"a": new(big.Int).Lsh(big.NewInt(1), 70),
+		"b": big.NewRat(1, 8),
+		"c": big.NewRat(1, 3),
+	})
+
+	var buf bytes.Buffer
+	if err := ll.WriteJSON(&buf, JSONOptions{}); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	want := `[{"a":1180591620717411303424,"b":0.125,"c":0.333333333333333333333333333333}]`
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+
+	lit, err := Postgres.Literal(testDecimal{text: "-0.10"})
+	if err != nil || lit != "-0.10" {
+		t.Errorf("Expected literal -0.10, got %q (%v)", lit, err)
+	}
+	lit, err = MySQL.Literal(big.NewRat(5, 2))
+	if err != nil || lit != "2.5" {
+		t.Errorf("Expected literal 2.5, got %q (%v)", lit, err)
+	}
+	if got := copyText(big.NewRat(1, 4)); got != "0.25" {
+		t.Errorf("Expected COPY text 0.25, got %q", got)
+	}
+}
+
+func TestBigTextOnlyKnownDecimals(t *testing.T) {
+	if _, ok := bigText(labelStringer("0042")); ok {
+		t.Error("Expected an unregistered Stringer not to be taken for a number")
+	}
+	if s, ok := bigText(&testDecimal{text: "1.5"}); !ok || s != "1.5" {
+		t.Errorf("Expected a registered pointer type to give 1.5, got %q", s)
+	}
+	if _, err := Postgres.Literal(labelStringer("0042")); err == nil {
+		t.Error("Expected no numeric literal for an unregistered Stringer")
+	}
+}
@@ -0,0 +1,163 @@
+package linkedlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BlobStrategy selects how a load handles large binary columns.
+type BlobStrategy int
+
+const (
+	// BlobLoad keeps the whole value, as for any other column.
+	BlobLoad BlobStrategy = iota
+	// BlobTruncate keeps at most BlobOptions.MaxBytes of each value.
+	BlobTruncate
+	// BlobLazy stores a *BlobRef in place of the value, which fetches it
+	// on demand through BlobOptions.Fetch.
+	BlobLazy
+)
+
+// BlobFetcher loads the value of column for a row, given the row as it was
+// loaded. It typically selects the column by the row's primary key.
+type BlobFetcher func(ctx context.Context, column string, row map[string]interface{}) ([]byte, error)
+
+// BlobOptions configures LoadOptions.Blobs.
+type BlobOptions struct {
+	Strategy BlobStrategy
+	// Columns are handled as blobs. With BlobLazy, listed columns missing
+	// from the result also get a BlobRef, so a query can leave them out
+	// and never transfer them.
+	Columns []string
+	// Detect also handles columns the driver reports as BLOB, BYTEA,
+	// VARBINARY or IMAGE types.
+	Detect bool
+	// MaxBytes is the size BlobTruncate cuts values to.
+	MaxBytes int
+	// Fetch loads values for BlobLazy.
+	Fetch BlobFetcher
+}
+
+// BlobRef stands in for a blob column loaded with BlobLazy.
+type BlobRef struct {
+	Column string
+	// Size is the value's length in the result, or -1 when the column was
+	// not selected.
+	Size  int
+	row   map[string]interface{}
+	fetch BlobFetcher
+}
+
+// Fetch loads the value.
+func (b *BlobRef) Fetch(ctx context.Context) ([]byte, error) {
+	if b.fetch == nil {
+		return nil, errors.New("blob has no fetcher")
+	}
+	return b.fetch(ctx, b.Column, b.row)
+}
+
+// String describes the reference.
+func (b *BlobRef) String() string {
+	if b.Size < 0 {
+		return fmt.Sprintf("<blob %s>", b.Column)
+	}
+	return fmt.Sprintf("<blob %s, %d bytes>", b.Column, b.Size)
+}
+
+// blobPlan is the per-load form of BlobOptions.
+type blobPlan struct {
+	opts    BlobOptions
+	index   []bool   // result columns handled as blobs
+	missing []string // lazy columns absent from the result
+}
+
+// blobTypes are database type names detected as blobs.
+var blobTypes = []string{"BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BYTEA", "VARBINARY", "IMAGE"}
+
+// newBlobPlan returns nil when opts leaves every column alone.
+func newBlobPlan(opts BlobOptions, cols []string, types []*sql.ColumnType) (*blobPlan, error) {
+	switch opts.Strategy {
+	case BlobLoad:
+		return nil, nil
+	case BlobTruncate:
+		if opts.MaxBytes < 0 {
+			return nil, errors.New("blob MaxBytes must not be negative")
+		}
+	case BlobLazy:
+		if opts.Fetch == nil {
+			return nil, errors.New("lazy blobs need a Fetch function")
+		}
+	default:
+		return nil, fmt.Errorf("unknown blob strategy %d", opts.Strategy)
+	}
+
+	p := &blobPlan{opts: opts, index: make([]bool, len(cols))}
+	for i, c := range cols {
+		p.index[i] = slices.Contains(opts.Columns, c)
+	}
+	if opts.Detect {
+		for i, ct := range types {
+			if i < len(p.index) && slices.Contains(blobTypes, strings.ToUpper(ct.DatabaseTypeName())) {
+				p.index[i] = true
+			}
+		}
+	}
+	if opts.Strategy == BlobLazy {
+		for _, c := range opts.Columns {
+			if !slices.Contains(cols, c) {
+				p.missing = append(p.missing, c)
+			}
+		}
+	}
+	return p, nil
+}
+
+// scanRowBlobs is scanRowAs with blob columns read as sql.RawBytes, so
+// truncated and lazy values are never copied in full.
+func scanRowBlobs(rows *sqlx.Rows, cols []string, p *blobPlan) (map[string]interface{}, error) {
+	if p == nil {
+		return scanRowAs(rows, cols)
+	}
+	values := make([]interface{}, len(cols))
+	for i := range values {
+		if p.index[i] {
+			values[i] = new(sql.RawBytes)
+		} else {
+			values[i] = new(interface{})
+		}
+	}
+	if err := rows.Scan(values...); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	rowData := make(map[string]interface{}, len(cols)+len(p.missing))
+	for i, col := range cols {
+		if !p.index[i] {
+			val := *values[i].(*interface{})
+			if b, ok := val.([]byte); ok {
+				val = string(b)
+			}
+			rowData[col] = val
+			continue
+		}
+		raw := *values[i].(*sql.RawBytes)
+		switch {
+		case raw == nil:
+			rowData[col] = nil
+		case p.opts.Strategy == BlobTruncate:
+			rowData[col] = string(raw[:min(len(raw), p.opts.MaxBytes)])
+		default:
+			rowData[col] = &BlobRef{Column: col, Size: len(raw), row: rowData, fetch: p.opts.Fetch}
+		}
+	}
+	for _, col := range p.missing {
+		rowData[col] = &BlobRef{Column: col, Size: -1, row: rowData, fetch: p.opts.Fetch}
+	}
+	return rowData, nil
+}
@@ -0,0 +1,70 @@
+package linkedlist
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestLoadBlobTruncate(t *testing.T) {
+	rows := queryRows(t, []string{"id", "body"},
+		[]interface{}{int64(1), bytes.Repeat([]byte("a"), 1000)},
+		[]interface{}{int64(2), []byte("short")},
+		[]interface{}{int64(3), nil})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{Blobs: BlobOptions{Strategy: BlobTruncate, Columns: []string{"body"}, MaxBytes: 8}})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := []interface{}{"aaaaaaaa", "short", nil}
+	for i, got := range columnValues(ll, "body") {
+		if got != want[i] {
+			t.Errorf("Row %d: expected %v, got %v", i+1, want[i], got)
+		}
+	}
+	if ll.head.Data["id"] != int64(1) {
+		t.Errorf("Expected other columns untouched, got %v", ll.head.Data["id"])
+	}
+}
+
+func TestLoadBlobLazy(t *testing.T) {
+	docs := map[int64][]byte{1: []byte("first doc"), 2: []byte("second doc")}
+	fetch := func(ctx context.Context, column string, row map[string]interface{}) ([]byte, error) {
+		return docs[row["id"].(int64)], nil
+	}
+	rows := queryRows(t, []string{"id", "body"},
+		[]interface{}{int64(1), []byte("first doc")},
+		[]interface{}{int64(2), []byte("second doc")})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{Blobs: BlobOptions{Strategy: BlobLazy, Columns: []string{"body", "thumbnail"}, Fetch: fetch}})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	ref, ok := ll.tail.Data["body"].(*BlobRef)
+	if !ok {
+		t.Fatalf("Expected *BlobRef, got %T", ll.tail.Data["body"])
+	}
+	if ref.Size != 10 {
+		t.Errorf("Expected size 10, got %d", ref.Size)
+	}
+	data, err := ref.Fetch(context.Background())
+	if err != nil || string(data) != "second doc" {
+		t.Errorf("Expected second doc, got %q, %v", data, err)
+	}
+
+	thumb, ok := ll.head.Data["thumbnail"].(*BlobRef)
+	if !ok || thumb.Size != -1 {
+		t.Fatalf("Expected a reference for the unselected column, got %v", ll.head.Data["thumbnail"])
+	}
+	if thumb.String() != "<blob thumbnail>" || ref.String() != "<blob body, 10 bytes>" {
+		t.Errorf("Unexpected descriptions %q and %q", thumb, ref)
+	}
+}
+
+func TestLoadBlobOptionErrors(t *testing.T) {
+	rows := queryRows(t, []string{"body"}, []interface{}{[]byte("x")})
+	if err := New().LoadFromSQLxWithOptions(rows, LoadOptions{Blobs: BlobOptions{Strategy: BlobLazy, Columns: []string{"body"}}}); err == nil {
+		t.Error("Expected error for lazy blobs without a fetcher")
+	}
+}
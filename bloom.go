@@ -0,0 +1,70 @@
+package linkedlist
+
+import (
+	"fmt"
+	"math"
+)
+
+// BloomFilter is a probabilistic set of a column's values built by
+// BuildBloom. MightContain never reports a false negative; false positives
+// occur at about the rate the filter was built for.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64 // number of bits
+	k    uint64 // number of hash functions
+}
+
+// BuildBloom builds a Bloom filter over the non-NULL values of column sized
+// for a false positive rate of fpRate, which must be between 0 and 1.
+// Numerically equal values match, as with GroupBy keys.
+func (ll *LinkedList) BuildBloom(column string, fpRate float64) (*BloomFilter, error) {
+	if !(fpRate > 0 && fpRate < 1) {
+		return nil, fmt.Errorf("false positive rate %v must be between 0 and 1", fpRate)
+	}
+
+	var hashes []uint64
+	err := ll.each(func(n *Node) error {
+		if v := n.Data[column]; v != nil {
+			hashes = append(hashes, hashValue(v))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	count := math.Max(float64(len(hashes)), 1)
+	m := uint64(math.Ceil(-count * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Max(math.Round(float64(m)/count*math.Ln2), 1))
+	bf := &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}
+	for _, h := range hashes {
+		bf.add(h)
+	}
+	return bf, nil
+}
+
+// add sets the k bits of a hash, derived by double hashing.
+func (bf *BloomFilter) add(h uint64) {
+	h1, h2 := h, h>>32|1
+	for i := uint64(0); i < bf.k; i++ {
+		pos := (h1 + i*h2) % bf.m
+		bf.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+// MightContain reports whether value may be in the column. A false result
+// means it definitely is not. NULL is never contained.
+func (bf *BloomFilter) MightContain(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	h := hashValue(value)
+	h1, h2 := h, h>>32|1
+	for i := uint64(0); i < bf.k; i++ {
+		pos := (h1 + i*h2) % bf.m
+		if bf.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package linkedlist
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBuildBloom_MembershipAndRate(t *testing.T) {
+	ll := New()
+	for i := 0; i < 10000; i++ {
+		ll.Append(map[string]interface{}{"sku": fmt.Sprintf("sku-%d", i)})
+	}
+	bf, err := ll.BuildBloom("sku", 0.01)
+	if err != nil {
+		t.Fatalf("BuildBloom failed: %v", err)
+	}
+	for i := 0; i < 10000; i++ {
+		if !bf.MightContain(fmt.Sprintf("sku-%d", i)) {
+			t.Fatalf("Expected sku-%d to be contained", i)
+		}
+	}
+	falsePositives := 0
+	for i := 10000; i < 20000; i++ {
+		if bf.MightContain(fmt.Sprintf("sku-%d", i)) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / 10000; rate > 0.02 {
+		t.Errorf("Expected false positive rate near 1%%, got %.2f%%", rate*100)
+	}
+}
+
+func TestBuildBloom_Values(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": int64(7)})
+	ll.Append(map[string]interface{}{"id": nil})
+	bf, err := ll.BuildBloom("id", 0.001)
+	if err != nil {
+		t.Fatalf("BuildBloom failed: %v", err)
+	}
+	if !bf.MightContain(7) || !bf.MightContain(7.0) {
+		t.Error("Expected numerically equal values to be contained")
+	}
+	if bf.MightContain(nil) {
+		t.Error("Expected NULL never to be contained")
+	}
+	for _, rate := range []float64{0, 1, -0.5} {
+		if _, err := ll.BuildBloom("id", rate); err == nil {
+			t.Errorf("Expected error for rate %v", rate)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package linkedlist
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// WithLenientBools lets bool fields scan the representations drivers use
+// for booleans: integers 0 and 1, the strings "t"/"f", "y"/"n", "yes"/"no",
+// "true"/"false", "on"/"off", "1"/"0" in any case, and the single bytes 0x00
+// and 0x01 of BIT(1) columns.
+func WithLenientBools() Option {
+	return func(ll *LinkedList) {
+		ll.lenientBools = true
+	}
+}
+
+// setLenientBool sets bool and *bool fields in lenient mode. It reports
+// false for other fields and for values that already are bools.
+func (s *scanner) setLenientBool(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (bool, error) {
+	if s == nil || !s.lenientBools {
+		return false, nil
+	}
+	target := fieldType
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+	if target.Kind() != reflect.Bool {
+		return false, nil
+	}
+	if _, ok := dataValue.(bool); ok {
+		return false, nil
+	}
+	b, err := lenientBool(dataValue)
+	if err != nil {
+		return true, err
+	}
+	v := reflect.New(target)
+	v.Elem().SetBool(b)
+	if fieldType.Kind() == reflect.Ptr {
+		field.Set(v)
+	} else {
+		field.Set(v.Elem())
+	}
+	return true, nil
+}
+
+// lenientBool interprets a driver's boolean encoding.
+func lenientBool(v interface{}) (bool, error) {
+	if i, ok := toInt64(v); ok {
+		switch i {
+		case 0:
+			return false, nil
+		case 1:
+			return true, nil
+		}
+		return false, fmt.Errorf("cannot convert %d to bool", i)
+	}
+	switch x := v.(type) {
+	case string, []byte:
+		switch s := valueText(x); strings.ToLower(strings.TrimSpace(s)) {
+		case "1", "t", "true", "y", "yes", "on", "\x01":
+			return true, nil
+		case "0", "f", "false", "n", "no", "off", "\x00":
+			return false, nil
+		default:
+			return false, fmt.Errorf("cannot convert %q to bool", s)
+		}
+	}
+	return false, fmt.Errorf("cannot convert %T to bool", v)
+}
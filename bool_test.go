@@ -0,0 +1,62 @@
+package linkedlist
+
+import "testing"
+
+func TestLenientBools(t *testing.T) {
+	type row struct {
+		Flag bool  `db:"flag"`
+		Opt  *bool `db:"opt"`
+	}
+	cases := []struct {
+		value interface{}
+		want  bool
+	}{
+		{int64(1), true},
+		{int64(0), false},
+		{"t", true},
+		{"F", false},
+		{"Y", true},
+		{"n", false},
+		{[]byte{1}, true},
+		{string([]byte{0}), false},
+		{true, true},
+	}
+	for _, c := range cases {
+		ll := New(WithLenientBools())
+		ll.Append(map[string]interface{}{"flag": c.value, "opt": c.value})
+		var dest row
+		if err := ll.ScanFirst(&dest); err != nil {
+			t.Fatalf("ScanFirst(%v) failed: %v", c.value, err)
+		}
+		if dest.Flag != c.want {
+			t.Errorf("Expected %v for %#v, got %v", c.want, c.value, dest.Flag)
+		}
+		if dest.Opt == nil || *dest.Opt != c.want {
+			t.Errorf("Expected pointer to %v for %#v, got %v", c.want, c.value, dest.Opt)
+		}
+	}
+}
+
+func TestLenientBoolsRejectsOtherValues(t *testing.T) {
+	ll := New(WithLenientBools())
+	ll.Append(map[string]interface{}{"flag": int64(2)})
+
+	var dest struct {
+		Flag bool `db:"flag"`
+	}
+	if err := ll.ScanFirst(&dest); err == nil {
+		t.Error("Expected error for 2, got nil")
+	}
+}
+
+func TestStrictBoolsByDefault(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"flag": "t"})
+
+	var dest struct {
+		Flag bool `db:"flag"`
+	}
+	if err := ll.ScanFirst(&dest); err == nil {
+		t.Error("Expected error scanning \"t\" without lenient mode, got nil")
+	}
+}
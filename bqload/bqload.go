@@ -0,0 +1,122 @@
+// Package bqload loads BigQuery query results into linked lists.
+//
+// Load reads rows through RowIterator, which takes rows as plain value
+// slices and the schema as Fields, so cloud.google.com/go/bigquery stays out
+// of this module's requirements. A *bigquery.RowIterator fits it with an
+// adapter like this one:
+//
+//	type bqRows struct{ it *bigquery.RowIterator }
+//
+//	func (r bqRows) Schema() []bqload.Field { return convertSchema(r.it.Schema) }
+//
+//	func (r bqRows) Next() ([]interface{}, error) {
+//		var row []bigquery.Value
+//		if err := r.it.Next(&row); err == iterator.Done {
+//			return nil, io.EOF
+//		} else if err != nil {
+//			return nil, err
+//		}
+//		out := make([]interface{}, len(row))
+//		for i, v := range row {
+//			out[i] = v
+//		}
+//		return out, nil
+//	}
+//
+// Note that the schema of a RowIterator is only known after the first call
+// to Next, so Load reads it after fetching the first row.
+package bqload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// Field describes a column of the result schema. Schema holds the fields of
+// a RECORD column.
+type Field struct {
+	Name     string
+	Repeated bool
+	Schema   []Field
+}
+
+// RowIterator yields result rows as values in schema order. Next returns
+// io.EOF after the last row.
+type RowIterator interface {
+	Schema() []Field
+	Next() ([]interface{}, error)
+}
+
+// Load appends every row of it to ll, keyed by the schema's column names.
+// RECORD values become nested map[string]interface{} rows and REPEATED
+// values []interface{}, so they scan into nested structs and slices.
+func Load(ll *linkedlist.LinkedList, it RowIterator) error {
+	if ll == nil {
+		return linkedlist.ErrNilList
+	}
+	for count := 1; ; count++ {
+		values, err := it.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row, err := record(it.Schema(), values)
+		if err != nil {
+			return fmt.Errorf("row %d: %w", count, err)
+		}
+		ll.Append(row)
+	}
+}
+
+// record maps the values of a row or RECORD to their field names.
+func record(schema []Field, values []interface{}) (map[string]interface{}, error) {
+	if len(values) != len(schema) {
+		return nil, fmt.Errorf("got %d values for %d fields", len(values), len(schema))
+	}
+	row := make(map[string]interface{}, len(schema))
+	for i, f := range schema {
+		v, err := value(f, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		row[f.Name] = v
+	}
+	return row, nil
+}
+
+// value converts a single field value.
+func value(f Field, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if f.Repeated {
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a repeated value, got %T", v)
+		}
+		elem := f
+		elem.Repeated = false
+		out := make([]interface{}, len(items))
+		for i, item := range items {
+			cv, err := value(elem, item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = cv
+		}
+		return out, nil
+	}
+	if len(f.Schema) > 0 {
+		fields, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a record, got %T", v)
+		}
+		return record(f.Schema, fields)
+	}
+	return v, nil
+}
@@ -0,0 +1,92 @@
+package bqload
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+type sliceIterator struct {
+	schema []Field
+	rows   [][]interface{}
+	err    error
+}
+
+func (it *sliceIterator) Schema() []Field { return it.schema }
+
+func (it *sliceIterator) Next() ([]interface{}, error) {
+	if len(it.rows) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+		return nil, io.EOF
+	}
+	row := it.rows[0]
+	it.rows = it.rows[1:]
+	return row, nil
+}
+
+var ordersSchema = []Field{
+	{Name: "id"},
+	{Name: "customer", Schema: []Field{{Name: "name"}, {Name: "tier"}}},
+	{Name: "items", Repeated: true, Schema: []Field{{Name: "sku"}, {Name: "qty"}}},
+	{Name: "tags", Repeated: true},
+}
+
+func TestLoad_NestedAndRepeated(t *testing.T) {
+	it := &sliceIterator{schema: ordersSchema, rows: [][]interface{}{
+		{int64(1), []interface{}{"Alice", "gold"}, []interface{}{
+			[]interface{}{"A-1", int64(2)},
+			[]interface{}{"B-7", int64(1)},
+		}, []interface{}{"rush"}},
+		{int64(2), nil, []interface{}{}, nil},
+	}}
+
+	ll := linkedlist.New()
+	if err := Load(ll, it); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", ll.Len())
+	}
+
+	type item struct {
+		SKU string `db:"sku"`
+		Qty int    `db:"qty"`
+	}
+	type order struct {
+		ID       int64 `db:"id"`
+		Customer struct {
+			Name string `db:"name"`
+		} `db:"customer"`
+		Items []item   `db:"items"`
+		Tags  []string `db:"tags"`
+	}
+	var orders []order
+	if err := ll.ToSlice(&orders); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	first := orders[0]
+	if first.Customer.Name != "Alice" || len(first.Items) != 2 || first.Items[1].SKU != "B-7" || first.Tags[0] != "rush" {
+		t.Errorf("Unexpected order: %+v", first)
+	}
+	if ll.Last().Data["customer"] != nil {
+		t.Errorf("Expected NULL record to stay nil, got %v", ll.Last().Data["customer"])
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	boom := errors.New("boom")
+	if err := Load(linkedlist.New(), &sliceIterator{err: boom}); !errors.Is(err, boom) {
+		t.Errorf("Expected iterator error, got %v", err)
+	}
+	bad := &sliceIterator{schema: ordersSchema, rows: [][]interface{}{{int64(1)}}}
+	if err := Load(linkedlist.New(), bad); err == nil {
+		t.Error("Expected error for a short row")
+	}
+	if err := Load(nil, bad); err != linkedlist.ErrNilList {
+		t.Errorf("Expected ErrNilList, got %v", err)
+	}
+}
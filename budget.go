@@ -0,0 +1,85 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMemoryBudget is returned when a load would exceed LoadOptions.MaxBytes.
+var ErrMemoryBudget = errors.New("linkedlist: memory budget exceeded")
+
+// EstimatedSize returns a rough size in bytes of the list's rows, estimated
+// as Stats.BytesEstimated is. Spilled rows are read back and counted too.
+func (ll *LinkedList) EstimatedSize() int64 {
+	if ll == nil {
+		return 0
+	}
+	size := ll.residentSize()
+	ll.spill.forEach(func(n *Node) error {
+		size += estimateSize(n.Data)
+		return nil
+	})
+	return size
+}
+
+// residentSize is EstimatedSize for the rows held in memory.
+func (ll *LinkedList) residentSize() int64 {
+	var size int64
+	for n := ll.front(); n != nil; n = n.succ() {
+		size += estimateSize(n.Data)
+	}
+	return size
+}
+
+// loadBudget tracks resident bytes against LoadOptions.MaxBytes. While a
+// load runs it is set as the list's budget, so appendNode can subtract the
+// rows evicted by WithMaxLen.
+type loadBudget struct {
+	max  int64
+	used int64
+}
+
+func (ll *LinkedList) newLoadBudget(max int64) *loadBudget {
+	if max <= 0 {
+		return nil
+	}
+	return &loadBudget{max: max, used: ll.residentSize()}
+}
+
+// evict accounts for a row removed by WithMaxLen.
+func (b *loadBudget) evict(n *Node) {
+	if b == nil {
+		return
+	}
+	n.expand()
+	b.used -= estimateSize(n.Data)
+}
+
+// admit accounts for a row of size bytes about to be appended. Without a
+// spill file it fails once the budget would be exceeded.
+func (b *loadBudget) admit(ll *LinkedList, size int64) error {
+	if b == nil {
+		return nil
+	}
+	if b.used+size > b.max && ll.spill == nil {
+		return fmt.Errorf("%w: %d bytes in memory, next row needs %d of %d", ErrMemoryBudget, b.used, size, b.max)
+	}
+	b.used += size
+	return nil
+}
+
+// release spills the oldest rows until the budget is met again.
+func (b *loadBudget) release(ll *LinkedList) error {
+	if b == nil || ll.spill == nil {
+		return nil
+	}
+	for b.used > b.max && ll.len > 1 {
+		size := estimateSize(ll.head.Data)
+		ll.spillHead()
+		if err := ll.spill.err; err != nil {
+			return err
+		}
+		b.used -= size
+	}
+	return nil
+}
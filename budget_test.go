@@ -0,0 +1,69 @@
+package linkedlist
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEstimatedSize(t *testing.T) {
+	var empty *LinkedList
+	if empty.EstimatedSize() != 0 {
+		t.Errorf("Expected 0 for nil list, got %d", empty.EstimatedSize())
+	}
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "abcd", "n": int64(1)})
+	ll.Append(map[string]interface{}{"name": nil})
+	// "name"+"abcd" + "n"+8 + "name"
+	if got := ll.EstimatedSize(); got != 21 {
+		t.Errorf("Expected 21, got %d", got)
+	}
+}
+
+func budgetRows(t *testing.T, n int) [][]interface{} {
+	t.Helper()
+	var values [][]interface{}
+	for i := 0; i < n; i++ {
+		values = append(values, []interface{}{strings.Repeat("x", 96)})
+	}
+	return values
+}
+
+func TestLoadMaxBytesAborts(t *testing.T) {
+	// Each row is "body" plus 96 bytes, 100 in all.
+	rows := queryRows(t, []string{"body"}, budgetRows(t, 10)...)
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{MaxBytes: 350})
+	if !errors.Is(err, ErrMemoryBudget) {
+		t.Fatalf("Expected ErrMemoryBudget, got %v", err)
+	}
+	if ll.Len() != 3 {
+		t.Errorf("Expected 3 rows loaded before the budget ran out, got %d", ll.Len())
+	}
+}
+
+func TestLoadMaxBytesSpills(t *testing.T) {
+	rows := queryRows(t, []string{"body"}, budgetRows(t, 10)...)
+	ll := New(WithSpill(t.TempDir(), 1000))
+	defer ll.Close()
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{MaxBytes: 350}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if ll.Len() != 10 || ll.Spilled() != 7 {
+		t.Errorf("Expected 10 rows with 7 spilled, got %d and %d", ll.Len(), ll.Spilled())
+	}
+	if got := ll.residentSize(); got > 350 {
+		t.Errorf("Expected at most 350 bytes in memory, got %d", got)
+	}
+	if ll.EstimatedSize() <= ll.residentSize() {
+		t.Error("Expected EstimatedSize to count spilled rows")
+	}
+}
+
+func TestLoadMaxBytesCountsEvictions(t *testing.T) {
+	rows := queryRows(t, []string{"body"}, budgetRows(t, 10)...)
+	ll := New(WithMaxLen(2, nil))
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{MaxBytes: 350}); err != nil {
+		t.Fatalf("Expected evicted rows not to count, got %v", err)
+	}
+}
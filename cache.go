@@ -0,0 +1,169 @@
+package linkedlist
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Cache caches query results keyed by statement and arguments. Concurrent
+// misses for the same key share a single query. A Cache is safe for
+// concurrent use.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	clock      Clock
+
+	mu       sync.Mutex
+	entries  map[string]*cacheEntry
+	inflight map[string]*cacheCall
+}
+
+type cacheEntry struct {
+	list    *LinkedList
+	expires time.Time
+}
+
+// cacheCall is a query in progress that other callers can wait for.
+type cacheCall struct {
+	done chan struct{}
+	list *LinkedList
+	err  error
+}
+
+// NewCache returns a cache keeping results for ttl and at most maxEntries
+// results. A non-positive maxEntries leaves the cache unbounded.
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		clock:      SystemClock{},
+		entries:    make(map[string]*cacheEntry),
+		inflight:   make(map[string]*cacheCall),
+	}
+}
+
+// Query returns the rows of query from the cache, running it on db if there
+// is no fresh result. Each caller gets its own frozen copy of the rows:
+// mutating it fails with ErrFrozen, and writing to a row's Data directly
+// does not reach the cache or other callers. A shared query is not
+// cancelled when the caller that started it gives up, so the callers still
+// waiting get its result. Errors are not cached.
+func (c *Cache) Query(ctx context.Context, db sqlx.QueryerContext, query string, args ...interface{}) (*LinkedList, error) {
+	key := encodeKey([]interface{}{query, encodeKey(args)})
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		if c.clock.Now().Before(e.expires) {
+			c.mu.Unlock()
+			return cacheCopy(e.list), nil
+		}
+		delete(c.entries, key)
+	}
+	call, ok := c.inflight[key]
+	if !ok {
+		call = &cacheCall{done: make(chan struct{})}
+		c.inflight[key] = call
+		go c.run(context.WithoutCancel(ctx), db, key, call, query, args)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return cacheCopy(call.list), nil
+}
+
+// run executes a shared query and stores its result.
+func (c *Cache) run(ctx context.Context, db sqlx.QueryerContext, key string, call *cacheCall, query string, args []interface{}) {
+	list := New()
+	call.err = list.QueryContext(ctx, db, query, args...)
+	if call.err == nil {
+		call.list = list
+	}
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if call.err == nil {
+		c.store(key, call.list)
+	}
+	c.mu.Unlock()
+	close(call.done)
+}
+
+// cacheCopy returns a frozen list holding deep copies of the rows of a
+// cached result. Cached lists are never changed, so copying needs no lock.
+func cacheCopy(list *LinkedList) *LinkedList {
+	view := &LinkedList{meta: maps.Clone(list.meta), columnOrder: list.columnOrder}
+	for n := list.front(); n != nil; n = n.succ() {
+		data := make(map[string]interface{}, len(n.Data))
+		for k, v := range n.Data {
+			data[k] = copyValue(v)
+		}
+		c := n.derive(data)
+		c.frozen = true
+		view.appendNode(c)
+	}
+	view.frozen = true
+	return view
+}
+
+// copyValue returns a copy of v that shares no mutable memory with it.
+func copyValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case []byte:
+		return bytes.Clone(x)
+	case []interface{}:
+		c := make([]interface{}, len(x))
+		for i, e := range x {
+			c[i] = copyValue(e)
+		}
+		return c
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			c[k] = copyValue(e)
+		}
+		return c
+	}
+	return v
+}
+
+// store adds a result, evicting the entry closest to expiry when full.
+// c.mu must be held.
+func (c *Cache) store(key string, list *LinkedList) {
+	if c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		var oldest string
+		var oldestExpiry time.Time
+		for k, e := range c.entries {
+			if oldest == "" || e.expires.Before(oldestExpiry) {
+				oldest, oldestExpiry = k, e.expires
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = &cacheEntry{list: list, expires: c.clock.Now().Add(c.ttl)}
+}
+
+// Invalidate drops every cached result.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// Len returns the number of cached results.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
@@ -0,0 +1,159 @@
+package linkedlist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+func cacheDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return sqlx.NewDb(sqlDB, "sqlmock"), mock
+}
+
+func TestCache_HitsAndExpiry(t *testing.T) {
+	db, mock := cacheDB(t)
+	mock.ExpectQuery("SELECT id FROM t").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery("SELECT id FROM t").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewCache(time.Minute, 0)
+	cache.clock = clock
+	ctx := context.Background()
+
+	first, err := cache.Query(ctx, db, "SELECT id FROM t", 1)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	second, err := cache.Query(ctx, db, "SELECT id FROM t", 1)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if second.First().Data["id"] != int64(1) {
+		t.Errorf("Expected cached row, got %v", second.First().Data)
+	}
+	if !first.IsFrozen() {
+		t.Error("Expected cached lists to be frozen")
+	}
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	third, err := cache.Query(ctx, db, "SELECT id FROM t", 1)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if third.First().Data["id"] != int64(2) {
+		t.Errorf("Expected refreshed row after TTL, got %v", third.First().Data)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCache_Singleflight(t *testing.T) {
+	db, mock := cacheDB(t)
+	mock.ExpectQuery("SELECT").WillDelayFor(50 * time.Millisecond).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	cache := NewCache(time.Minute, 0)
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ll, err := cache.Query(context.Background(), db, "SELECT id FROM t")
+			if err == nil && ll.Len() != 1 {
+				t.Errorf("Expected 1 row, got %d", ll.Len())
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Query failed: %v", err)
+		}
+	}
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	db, mock := cacheDB(t)
+	for i := 0; i < 3; i++ {
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i))
+	}
+	cache := NewCache(time.Minute, 2)
+	for _, arg := range []int{1, 2, 3} {
+		if _, err := cache.Query(context.Background(), db, "SELECT id FROM t WHERE id = ?", arg); err != nil {
+			t.Fatalf("Query failed: %v", err)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 cached results, got %d", cache.Len())
+	}
+	cache.Invalidate()
+	if cache.Len() != 0 {
+		t.Errorf("Expected empty cache, got %d", cache.Len())
+	}
+}
+
+func TestCache_CallersGetCopies(t *testing.T) {
+	db, mock := cacheDB(t)
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	cache := NewCache(time.Minute, 0)
+	first, err := cache.Query(context.Background(), db, "SELECT id FROM t")
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	first.First().Data["id"] = int64(9)
+
+	second, err := cache.Query(context.Background(), db, "SELECT id FROM t")
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if second.First().Data["id"] != int64(1) {
+		t.Errorf("Expected cached row to be unchanged, got %v", second.First().Data)
+	}
+}
+
+func TestCache_LeaderCancelDoesNotFailWaiters(t *testing.T) {
+	db, mock := cacheDB(t)
+	mock.ExpectQuery("SELECT").WillDelayFor(50 * time.Millisecond).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	cache := NewCache(time.Minute, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() {
+		_, err := cache.Query(ctx, db, "SELECT id FROM t")
+		leader <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	waiter := make(chan error, 1)
+	go func() {
+		ll, err := cache.Query(context.Background(), db, "SELECT id FROM t")
+		if err == nil && ll.Len() != 1 {
+			err = fmt.Errorf("expected 1 row, got %d", ll.Len())
+		}
+		waiter <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-leader; err != context.Canceled {
+		t.Errorf("Expected leader to see context.Canceled, got %v", err)
+	}
+	if err := <-waiter; err != nil {
+		t.Errorf("Expected waiter to get the shared result, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Change operations understood by ApplyChanges, matched case-insensitively.
+var changeOps = map[string]string{
+	"insert": "insert", "i": "insert", "c": "insert", "create": "insert",
+	"update": "update", "u": "update",
+	"upsert": "update",
+	"delete": "delete", "d": "delete",
+}
+
+// ApplyChanges applies a stream of change rows, in order, to a list keyed by
+// keyColumn. Each change row carries its operation in opColumn: "insert"
+// ("i", "c", "create"), "update" ("u", "upsert") or "delete" ("d"). Inserts
+// and updates replace the row with the change's values, minus opColumn, or
+// append it when the key is new, so replaying a change is harmless. Deletes
+// of unknown keys are ignored. The changes are validated first; if any has
+// an unknown operation the list is not modified. Lists with spilled rows
+// return ErrSpilled.
+func (ll *LinkedList) ApplyChanges(changes *LinkedList, keyColumn, opColumn string) error {
+	return ll.ApplyChangesOn(changes, []string{keyColumn}, opColumn)
+}
+
+// ApplyChangesOn is ApplyChanges for a key of one or more columns, such as
+// []string{"tenant_id", "id"}.
+func (ll *LinkedList) ApplyChangesOn(changes *LinkedList, keyColumns []string, opColumn string) error {
+	if ll == nil {
+		return ErrNilList
+	}
+	if len(keyColumns) == 0 {
+		return errors.New("changes need at least one key column")
+	}
+
+	var rows []*Node
+	var ops []string
+	err := changes.each(func(c *Node) error {
+		raw, _ := c.Data[opColumn].(string)
+		op, ok := changeOps[strings.ToLower(raw)]
+		if !ok {
+			return fmt.Errorf("unknown change operation %v at row %d", c.Data[opColumn], len(rows)+1)
+		}
+		rows = append(rows, c)
+		ops = append(ops, op)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	index := make(map[string]*Node, ll.len)
+	for n := ll.front(); n != nil; n = n.succ() {
+		index[rowKey(n.Data, keyColumns)] = n
+	}
+
+	deleted := make(map[*Node]bool)
+	for i, c := range rows {
+		op := ops[i]
+		k := rowKey(c.Data, keyColumns)
+		existing := index[k]
+		if op == "delete" {
+			if existing != nil {
+				deleted[existing] = true
+				delete(index, k)
+			}
+			continue
+		}
+
+		row := make(map[string]interface{}, len(c.Data))
+		for col, v := range c.Data {
+			if col != opColumn {
+				row[col] = v
+			}
+		}
+		if existing != nil {
+			existing.Data = row
+			existing.sharedData = false
+			continue
+		}
+		n := c.derive(row)
+		ll.appendNode(n)
+		index[k] = n
+	}
+
+	if len(deleted) > 0 {
+		var prev *Node
+		for n := ll.head; n != nil; {
+			next := n.next
+			if deleted[n] {
+				ll.unlink(prev, n)
+			} else {
+				prev = n
+			}
+			n = next
+		}
+		ll.mods++
+	}
+	ll.record("update", len(rows), "ApplyChanges key=%s", strings.Join(keyColumns, ","))
+	return nil
+}
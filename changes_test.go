@@ -0,0 +1,48 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyChanges(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	ll.Append(map[string]interface{}{"id": 2, "name": "Bob"})
+	ll.Append(map[string]interface{}{"id": 3, "name": "Carol"})
+	view := ll.Freeze()
+
+	changes := New()
+	changes.Append(map[string]interface{}{"op": "U", "id": int64(2), "name": "Robert"})
+	changes.Append(map[string]interface{}{"op": "d", "id": 1})
+	changes.Append(map[string]interface{}{"op": "insert", "id": 4, "name": "Dan"})
+	changes.Append(map[string]interface{}{"op": "delete", "id": 99})
+	changes.Append(map[string]interface{}{"op": "c", "id": 1, "name": "Alicia"})
+
+	if err := ll.ApplyChanges(changes, "id", "op"); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	if got := names(ll); !reflect.DeepEqual(got, []string{"Robert", "Carol", "Dan", "Alicia"}) {
+		t.Errorf("Unexpected rows after changes: %v", got)
+	}
+	if _, ok := ll.Last().Data["op"]; ok {
+		t.Error("Expected the operation column to be stripped")
+	}
+	if got := names(view); !reflect.DeepEqual(got, []string{"Alice", "Bob", "Carol"}) {
+		t.Errorf("Expected frozen view to be unchanged, got %v", got)
+	}
+}
+
+func TestApplyChanges_UnknownOp(t *testing.T) {
+	ll := peopleList()
+	changes := New()
+	changes.Append(map[string]interface{}{"op": "delete", "name": "Alice"})
+	changes.Append(map[string]interface{}{"op": "truncate"})
+
+	if err := ll.ApplyChanges(changes, "name", "op"); err == nil {
+		t.Fatal("Expected error for unknown operation")
+	}
+	if ll.Len() != 4 {
+		t.Errorf("Expected list to be unchanged, got %d rows", ll.Len())
+	}
+}
@@ -0,0 +1,130 @@
+package linkedlist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Checkpoint records how far a load got, so it can be resumed with
+// LoadOptions.ResumeFrom.
+type Checkpoint struct {
+	// Offset is the number of rows of the result consumed so far, counting
+	// those of any load this one resumed.
+	Offset int
+	// Key is the LoadOptions.CheckpointKey column of the last row, or nil
+	// when no key column is set.
+	Key interface{}
+}
+
+// checkpointer tracks rows for LoadOptions checkpoints.
+type checkpointer struct {
+	every   int
+	key     string
+	fn      func(Checkpoint) error
+	resume  *Checkpoint
+	last    Checkpoint
+	pending int
+}
+
+func newCheckpointer(opts LoadOptions) *checkpointer {
+	c := &checkpointer{every: opts.CheckpointEvery, key: opts.CheckpointKey, fn: opts.OnCheckpoint, resume: opts.ResumeFrom}
+	if c.resume != nil && c.resume.Key != nil {
+		c.last = *c.resume
+	}
+	return c
+}
+
+// skip reports whether the next row was loaded before an offset checkpoint
+// and passes over it if so.
+func (c *checkpointer) skip() bool {
+	if c.resume == nil || c.resume.Key != nil || c.last.Offset >= c.resume.Offset {
+		return false
+	}
+	c.last.Offset++
+	return true
+}
+
+// advance notes a loaded row, calling the callback when one is due.
+func (c *checkpointer) advance(row map[string]interface{}) error {
+	c.last.Offset++
+	if c.key != "" {
+		c.last.Key = row[c.key]
+	}
+	c.pending++
+	if c.every > 0 && c.pending >= c.every {
+		return c.flush()
+	}
+	return nil
+}
+
+// flush calls the callback for rows not yet covered by a checkpoint.
+func (c *checkpointer) flush() error {
+	if c.fn == nil || c.pending == 0 {
+		return nil
+	}
+	c.pending = 0
+	if err := c.fn(c.last); err != nil {
+		return fmt.Errorf("checkpoint at offset %d: %w", c.last.Offset, err)
+	}
+	return nil
+}
+
+// QueryWithOptions runs query on db and appends the resulting rows to the
+// list using opts. When opts.ResumeFrom holds a key, query is wrapped to
+// return only rows whose CheckpointKey column sorts after it, ordered by
+// that column, so the database skips the finished part of the extract.
+// The key column must be a column name of the result, which is quoted for
+// the driver's dialect, and must be unique: rows sharing the checkpoint's
+// key are not loaded again.
+func (ll *LinkedList) QueryWithOptions(ctx context.Context, db sqlx.QueryerContext, query string, opts LoadOptions, args ...interface{}) (err error) {
+	if ll == nil {
+		return ErrNilList
+	}
+	ctx, span := ll.startSpan(ctx, "QueryContext")
+	before := ll.Len()
+	defer func() { endSpan(span, ll.Len()-before, err) }()
+
+	if cp := opts.ResumeFrom; cp != nil && cp.Key != nil {
+		if opts.CheckpointKey == "" {
+			return fmt.Errorf("resuming from key %v requires CheckpointKey", cp.Key)
+		}
+		query, args = resumeQuery(db, query, opts.CheckpointKey, cp.Key, args)
+	}
+	rows, err := db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		ll.noteError("load", err)
+		return err
+	}
+	defer rows.Close()
+	return ll.LoadFromSQLxContext(ctx, rows, opts)
+}
+
+// resumeQuery restricts query to rows after key in column.
+func resumeQuery(db sqlx.QueryerContext, query, column string, key interface{}, args []interface{}) (string, []interface{}) {
+	driver := ""
+	if d, ok := db.(interface{ DriverName() string }); ok {
+		driver = d.DriverName()
+	}
+	col := driverDialect(driver).quoteName(column)
+	q := fmt.Sprintf("SELECT * FROM (%s) resume_q WHERE %s > ? ORDER BY %s", query, col, col)
+	if driver != "" {
+		q = sqlx.Rebind(sqlx.BindType(driver), q)
+	}
+	return q, append(append([]interface{}(nil), args...), key)
+}
+
+// driverDialect returns the dialect of a database/sql driver name. Unknown
+// drivers get Postgres, whose quoting is standard SQL.
+func driverDialect(driver string) Dialect {
+	switch driver {
+	case "mysql":
+		return MySQL
+	case "sqlite", "sqlite3":
+		return SQLite
+	case "sqlserver", "mssql", "azuresql":
+		return SQLServer
+	}
+	return Postgres
+}
@@ -0,0 +1,118 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLoadCheckpoints(t *testing.T) {
+	rows := queryRows(t, []string{"id"},
+		[]interface{}{int64(1)}, []interface{}{int64(2)}, []interface{}{int64(3)},
+		[]interface{}{int64(4)}, []interface{}{int64(5)})
+	var got []Checkpoint
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{
+		CheckpointEvery: 2,
+		CheckpointKey:   "id",
+		OnCheckpoint: func(cp Checkpoint) error {
+			got = append(got, cp)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := []Checkpoint{{2, int64(2)}, {4, int64(4)}, {5, int64(5)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected checkpoints %v, got %v", want, got)
+	}
+}
+
+func TestLoadCheckpointError(t *testing.T) {
+	rows := queryRows(t, []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)}, []interface{}{int64(3)})
+	boom := errors.New("disk full")
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{
+		CheckpointEvery: 1,
+		OnCheckpoint:    func(Checkpoint) error { return boom },
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("Expected checkpoint error, got %v", err)
+	}
+	if ll.Len() != 1 {
+		t.Errorf("Expected load to stop after 1 row, got %d", ll.Len())
+	}
+}
+
+func TestLoadResumeFromOffset(t *testing.T) {
+	rows := queryRows(t, []string{"id"},
+		[]interface{}{int64(1)}, []interface{}{int64(2)}, []interface{}{int64(3)}, []interface{}{int64(4)})
+	var last Checkpoint
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{
+		RowNumbers:      true,
+		ResumeFrom:      &Checkpoint{Offset: 2},
+		CheckpointEvery: 10,
+		OnCheckpoint:    func(cp Checkpoint) error { last = cp; return nil },
+	})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := []interface{}{int64(3), int64(4)}
+	if got := columnValues(ll, "id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if ll.head.RowNum() != 3 {
+		t.Errorf("Expected row numbers to continue at 3, got %d", ll.head.RowNum())
+	}
+	if last.Offset != 4 {
+		t.Errorf("Expected final checkpoint at offset 4, got %d", last.Offset)
+	}
+}
+
+func TestQueryWithOptionsResumeFromKey(t *testing.T) {
+	db, mock := cacheDB(t)
+	mock.ExpectQuery(`SELECT \* FROM \(SELECT id FROM t WHERE region = \?\) resume_q WHERE "id" > \? ORDER BY "id"`).
+		WithArgs("eu", int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(8)).AddRow(int64(9)))
+
+	var last Checkpoint
+	ll := New()
+	err := ll.QueryWithOptions(context.Background(), db, "SELECT id FROM t WHERE region = ?", LoadOptions{
+		CheckpointKey:   "id",
+		CheckpointEvery: 100,
+		ResumeFrom:      &Checkpoint{Offset: 7, Key: int64(7)},
+		OnCheckpoint:    func(cp Checkpoint) error { last = cp; return nil },
+	}, "eu")
+	if err != nil {
+		t.Fatalf("QueryWithOptions failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Errorf("Expected 2 rows, got %d", ll.Len())
+	}
+	if want := (Checkpoint{Offset: 9, Key: int64(9)}); last != want {
+		t.Errorf("Expected %v, got %v", want, last)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	err = ll.QueryWithOptions(context.Background(), db, "SELECT id FROM t", LoadOptions{ResumeFrom: &Checkpoint{Key: 1}})
+	if err == nil {
+		t.Error("Expected error resuming from a key without CheckpointKey")
+	}
+}
+
+func TestResumeQueryQuotesKey(t *testing.T) {
+	q, _ := resumeQuery(nil, "SELECT 1", "id) OR (1=1", 1, nil)
+	if want := `SELECT * FROM (SELECT 1) resume_q WHERE "id) OR (1=1" > ? ORDER BY "id) OR (1=1"`; q != want {
+		t.Errorf("Expected %s, got %s", want, q)
+	}
+	if got := driverDialect("mysql"); got != MySQL {
+		t.Errorf("Expected MySQL, got %v", got)
+	}
+}
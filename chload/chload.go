@@ -0,0 +1,96 @@
+// Package chload loads ClickHouse native protocol results into linked lists
+// without the precision loss of database/sql flattening.
+//
+// Rows and ColumnType are the subsets of clickhouse-go's driver.Rows and
+// driver.ColumnType that Load uses, so the driver's values fit them as they
+// are and only the column type slice needs converting:
+//
+//	rows, err := conn.Query(ctx, "SELECT ...")
+//	cols := rows.ColumnTypes()
+//	types := make([]chload.ColumnType, len(cols))
+//	for i, c := range cols {
+//		types[i] = c
+//	}
+//	err = chload.Load(ll, rows, types)
+//
+// Every value is scanned into the column's native scan type, so Data holds:
+//
+//	Decimal(P, S)          the driver's decimal type (decimal.Decimal)
+//	DateTime, DateTime64   time.Time with the column's precision and zone
+//	Date, Date32           time.Time at midnight UTC
+//	Int*/UInt*/Float*      the matching sized Go type, e.g. uint64, int128 as *big.Int
+//	Array(T)               []T, e.g. []string or []time.Time
+//	Map(K, V)              map[K]V
+//	Nullable(T)            T, or nil for NULL
+//	LowCardinality(T)      T
+package chload
+
+import (
+	"fmt"
+	"reflect"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// ColumnType describes a result column.
+type ColumnType interface {
+	Name() string
+	DatabaseTypeName() string
+	ScanType() reflect.Type
+}
+
+// Rows is a ClickHouse result set.
+type Rows interface {
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+}
+
+// Load appends every row of rows to ll, keyed by column name and holding
+// native values as described in the package documentation.
+func Load(ll *linkedlist.LinkedList, rows Rows, columns []ColumnType) error {
+	if ll == nil {
+		return linkedlist.ErrNilList
+	}
+	for i, c := range columns {
+		if c.ScanType() == nil {
+			return fmt.Errorf("column %d (%s) has no scan type", i, c.Name())
+		}
+	}
+
+	for count := 1; rows.Next(); count++ {
+		dest := make([]interface{}, len(columns))
+		for i, c := range columns {
+			dest[i] = reflect.New(c.ScanType()).Interface()
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return fmt.Errorf("failed to scan row %d: %w", count, err)
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			row[c.Name()] = native(reflect.ValueOf(dest[i]).Elem())
+		}
+		ll.Append(row)
+	}
+	return rows.Err()
+}
+
+// native unwraps the pointers used for Nullable columns.
+func native(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr && !isBigInt(v.Type()) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// isBigInt reports whether t is *big.Int, which the driver uses for
+// 128- and 256-bit integers and which must stay a pointer.
+func isBigInt(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().PkgPath() == "math/big" && t.Elem().Name() == "Int"
+}
@@ -0,0 +1,85 @@
+package chload
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+type column struct {
+	name, dbType string
+	scanType     reflect.Type
+}
+
+func (c column) Name() string             { return c.name }
+func (c column) DatabaseTypeName() string { return c.dbType }
+func (c column) ScanType() reflect.Type   { return c.scanType }
+
+// fakeRows scans prepared values into the destinations like the driver does.
+type fakeRows struct {
+	rows [][]interface{}
+	err  error
+}
+
+func (r *fakeRows) Next() bool { return len(r.rows) > 0 }
+func (r *fakeRows) Err() error { return r.err }
+
+func (r *fakeRows) Scan(dest ...interface{}) error {
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	for i, v := range row {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(v))
+	}
+	return nil
+}
+
+func TestLoad_NativeTypes(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 123456789, time.UTC)
+	score := "42.5"
+	columns := []ColumnType{
+		column{"id", "UInt64", reflect.TypeOf(uint64(0))},
+		column{"at", "DateTime64(9)", reflect.TypeOf(time.Time{})},
+		column{"tags", "Array(String)", reflect.TypeOf([]string(nil))},
+		column{"score", "Nullable(String)", reflect.TypeOf((*string)(nil))},
+		column{"big", "Int128", reflect.TypeOf((*big.Int)(nil))},
+	}
+	rows := &fakeRows{rows: [][]interface{}{
+		{uint64(1), at, []string{"a", "b"}, &score, big.NewInt(7)},
+		{uint64(2), at, []string{}, (*string)(nil), big.NewInt(8)},
+	}}
+
+	ll := linkedlist.New()
+	if err := Load(ll, rows, columns); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	first := ll.First().Data
+	if first["id"] != uint64(1) || !first["at"].(time.Time).Equal(at) {
+		t.Errorf("Unexpected native values: %v", first)
+	}
+	if !reflect.DeepEqual(first["tags"], []string{"a", "b"}) || first["score"] != "42.5" {
+		t.Errorf("Unexpected array or nullable: %v", first)
+	}
+	if b, ok := first["big"].(*big.Int); !ok || b.Int64() != 7 {
+		t.Errorf("Expected *big.Int, got %T", first["big"])
+	}
+	if ll.Last().Data["score"] != nil {
+		t.Errorf("Expected NULL score, got %v", ll.Last().Data["score"])
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	boom := errors.New("boom")
+	if err := Load(linkedlist.New(), &fakeRows{err: boom}, nil); !errors.Is(err, boom) {
+		t.Errorf("Expected rows error, got %v", err)
+	}
+	if err := Load(linkedlist.New(), &fakeRows{}, []ColumnType{column{name: "x"}}); err == nil {
+		t.Error("Expected error for a column without scan type")
+	}
+	if err := Load(nil, &fakeRows{}, nil); err != linkedlist.ErrNilList {
+		t.Errorf("Expected ErrNilList, got %v", err)
+	}
+}
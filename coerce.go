@@ -0,0 +1,175 @@
+package linkedlist
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Coercer converts a single non-nil column value to another representation.
+type Coercer interface {
+	Coerce(v interface{}) (interface{}, error)
+}
+
+// CoercerFunc adapts an ordinary function to the Coercer interface.
+type CoercerFunc func(v interface{}) (interface{}, error)
+
+// Coerce calls f(v).
+func (f CoercerFunc) Coerce(v interface{}) (interface{}, error) {
+	return f(v)
+}
+
+// kindTypes maps the kinds supported by KindCoercer to their Go types.
+var kindTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
+
+// KindCoercer returns a Coercer converting values to the basic Go type of the
+// given kind. Strings and byte slices are parsed, numbers are converted, and
+// booleans map to and from 0 and 1.
+func KindCoercer(kind reflect.Kind) Coercer {
+	return CoercerFunc(func(v interface{}) (interface{}, error) {
+		typ, ok := kindTypes[kind]
+		if !ok {
+			return nil, fmt.Errorf("unsupported coercion kind %v", kind)
+		}
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+
+		switch kind {
+		case reflect.String:
+			if t, ok := v.(time.Time); ok {
+				return t.Format(time.RFC3339), nil
+			}
+			return fmt.Sprint(v), nil
+		case reflect.Bool:
+			switch x := v.(type) {
+			case bool:
+				return x, nil
+			case string:
+				return strconv.ParseBool(x)
+			}
+			if f, ok := toFloat64(v); ok {
+				return f != 0, nil
+			}
+		case reflect.Float32, reflect.Float64:
+			if s, ok := v.(string); ok {
+				f, err := strconv.ParseFloat(s, 64)
+				if err != nil {
+					return nil, err
+				}
+				v = f
+			}
+			if f, ok := toFloat64(v); ok {
+				return reflect.ValueOf(f).Convert(typ).Interface(), nil
+			}
+		default:
+			switch x := v.(type) {
+			case string:
+				i, err := strconv.ParseInt(x, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				v = i
+			case bool:
+				if x {
+					v = 1
+				} else {
+					v = 0
+				}
+			}
+			if i, ok := toInt64(v); ok {
+				return reflect.ValueOf(i).Convert(typ).Interface(), nil
+			}
+			if f, ok := toFloat64(v); ok && f == float64(int64(f)) {
+				return reflect.ValueOf(int64(f)).Convert(typ).Interface(), nil
+			}
+		}
+
+		return nil, fmt.Errorf("cannot coerce %T to %v", v, kind)
+	})
+}
+
+// TimeCoercer returns a Coercer parsing strings and byte slices into
+// time.Time using the given layouts in order. It defaults to RFC 3339 and the
+// common SQL "2006-01-02 15:04:05" and "2006-01-02" layouts.
+func TimeCoercer(layouts ...string) Coercer {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+	}
+	return CoercerFunc(func(v interface{}) (interface{}, error) {
+		switch x := v.(type) {
+		case time.Time:
+			return x, nil
+		case []byte:
+			v = string(x)
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot coerce %T to time.Time", v)
+		}
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot parse %q as time", s)
+	})
+}
+
+// CoerceColumn converts the value of column in every node with c. Missing and
+// NULL values are left untouched. If any value fails to convert the list is
+// not modified and the error names the offending row.
+func (ll *LinkedList) CoerceColumn(column string, c Coercer) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	converted := make([]interface{}, 0, ll.len)
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		v, ok := n.Data[column]
+		if !ok || v == nil {
+			converted = append(converted, v)
+			continue
+		}
+		cv, err := c.Coerce(v)
+		if err != nil {
+			return fmt.Errorf("error coercing column %s at row %d: %w", column, pos, err)
+		}
+		converted = append(converted, cv)
+	}
+
+	i := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		if v, ok := n.Data[column]; ok && v != nil {
+			n.own()
+			n.Data[column] = converted[i]
+		}
+		i++
+	}
+	ll.record("update", ll.len, "CoerceColumn %s", column)
+	return nil
+}
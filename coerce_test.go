@@ -0,0 +1,60 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCoerceColumn_Kinds(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"active": int64(1), "name": []byte("alice"), "age": "42"})
+	ll.Append(map[string]interface{}{"active": "f", "name": nil, "age": 3.0})
+
+	if err := ll.CoerceColumn("active", KindCoercer(reflect.Bool)); err != nil {
+		t.Fatalf("CoerceColumn failed: %v", err)
+	}
+	if err := ll.CoerceColumn("name", KindCoercer(reflect.String)); err != nil {
+		t.Fatalf("CoerceColumn failed: %v", err)
+	}
+	if err := ll.CoerceColumn("age", KindCoercer(reflect.Int)); err != nil {
+		t.Fatalf("CoerceColumn failed: %v", err)
+	}
+
+	first, last := ll.First().Data, ll.Last().Data
+	if first["active"] != true || last["active"] != false {
+		t.Errorf("Unexpected bools: %v, %v", first["active"], last["active"])
+	}
+	if first["name"] != "alice" || last["name"] != nil {
+		t.Errorf("Unexpected names: %v, %v", first["name"], last["name"])
+	}
+	if first["age"] != 42 || last["age"] != 3 {
+		t.Errorf("Unexpected ages: %#v, %#v", first["age"], last["age"])
+	}
+}
+
+func TestCoerceColumn_Time(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"created_at": "2024-03-01 10:00:00"})
+
+	if err := ll.CoerceColumn("created_at", TimeCoercer()); err != nil {
+		t.Fatalf("CoerceColumn failed: %v", err)
+	}
+	want := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	if got, ok := ll.First().Data["created_at"].(time.Time); !ok || !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, ll.First().Data["created_at"])
+	}
+}
+
+func TestCoerceColumn_ErrorLeavesListUntouched(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"age": "1"})
+	ll.Append(map[string]interface{}{"age": "not-a-number"})
+
+	if err := ll.CoerceColumn("age", KindCoercer(reflect.Int)); err == nil {
+		t.Fatal("Expected coercion error, got nil")
+	}
+	if ll.First().Data["age"] != "1" {
+		t.Errorf("Expected first row untouched, got %#v", ll.First().Data["age"])
+	}
+}
@@ -0,0 +1,330 @@
+// Package columnar converts linked lists to and from column-oriented record
+// batches. A Batch mirrors the layout of an Apache Arrow record batch (a
+// schema plus one typed, nullable column per field) without depending on the
+// Arrow module, so it can be fed to Arrow builders, DuckDB appenders or
+// Parquet writers with a thin adapter.
+package columnar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// DataType is the logical type of a column.
+type DataType int
+
+const (
+	// Null is a column holding only NULL values.
+	Null DataType = iota
+	// Int64 columns hold []int64 values.
+	Int64
+	// Float64 columns hold []float64 values.
+	Float64
+	// String columns hold []string values.
+	String
+	// Bool columns hold []bool values.
+	Bool
+	// Timestamp columns hold []time.Time values.
+	Timestamp
+	// Binary columns hold [][]byte values.
+	Binary
+	// Uint64 columns hold []uint64 values.
+	Uint64
+)
+
+// String returns the Arrow name of the type.
+func (t DataType) String() string {
+	switch t {
+	case Int64:
+		return "int64"
+	case Float64:
+		return "float64"
+	case String:
+		return "utf8"
+	case Bool:
+		return "bool"
+	case Timestamp:
+		return "timestamp"
+	case Binary:
+		return "binary"
+	case Uint64:
+		return "uint64"
+	}
+	return "null"
+}
+
+// Field describes one column of a Schema.
+type Field struct {
+	Name     string
+	Type     DataType
+	Nullable bool
+}
+
+// Column holds the values of one field. Values is a typed slice matching the
+// field's DataType; Valid[i] is false where row i is NULL.
+type Column struct {
+	Values interface{}
+	Valid  []bool
+}
+
+// Batch is a set of equal-length columns sharing a schema.
+type Batch struct {
+	Schema  []Field
+	Columns []Column
+	NumRows int
+}
+
+// ToBatches converts ll into record batches of at most batchSize rows
+// (all rows in one batch if batchSize <= 0). Columns are ordered by name and
+// their types are inferred from the values across the whole list. Unsigned
+// 64-bit integers get their own Uint64 type; a column mixing floats, signed
+// and unsigned integers widens to Float64. Mixing other types in one column
+// is an error.
+func ToBatches(ll *linkedlist.LinkedList, batchSize int) ([]*Batch, error) {
+	nodes := ll.Nodes()
+	schema, err := InferSchema(nodes)
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = len(nodes)
+	}
+
+	var batches []*Batch
+	for start := 0; start < len(nodes); start += batchSize {
+		end := start + batchSize
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		batch, err := buildBatch(schema, nodes[start:end])
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+// InferSchema derives a schema from the Data of nodes.
+func InferSchema(nodes []*linkedlist.Node) ([]Field, error) {
+	types := make(map[string]DataType)
+	nullable := make(map[string]bool)
+	for _, n := range nodes {
+		for k, v := range n.Data {
+			t := typeOf(v)
+			if t < 0 {
+				return nil, fmt.Errorf("column %s has unsupported type %T", k, v)
+			}
+			if v == nil {
+				nullable[k] = true
+			}
+			prev, seen := types[k]
+			switch {
+			case !seen || prev == Null:
+				types[k] = t
+			case t == Null || t == prev:
+			case isNumeric(prev) && isNumeric(t):
+				types[k] = Float64
+			default:
+				return nil, fmt.Errorf("column %s mixes %v and %v values", k, prev, t)
+			}
+		}
+	}
+	for _, n := range nodes {
+		for k := range types {
+			if _, ok := n.Data[k]; !ok {
+				nullable[k] = true
+			}
+		}
+	}
+
+	schema := make([]Field, 0, len(types))
+	for name, t := range types {
+		schema = append(schema, Field{Name: name, Type: t, Nullable: nullable[name]})
+	}
+	sort.Slice(schema, func(i, j int) bool { return schema[i].Name < schema[j].Name })
+	return schema, nil
+}
+
+// isNumeric reports whether t is one of the number types.
+func isNumeric(t DataType) bool {
+	return t == Int64 || t == Uint64 || t == Float64
+}
+
+// typeOf maps a Go value to its column type.
+func typeOf(v interface{}) DataType {
+	switch v.(type) {
+	case nil:
+		return Null
+	case int, int8, int16, int32, int64, uint8, uint16, uint32:
+		return Int64
+	case uint, uint64:
+		return Uint64
+	case float32, float64:
+		return Float64
+	case string:
+		return String
+	case bool:
+		return Bool
+	case time.Time:
+		return Timestamp
+	case []byte:
+		return Binary
+	}
+	return -1
+}
+
+// buildBatch fills typed columns for nodes.
+func buildBatch(schema []Field, nodes []*linkedlist.Node) (*Batch, error) {
+	batch := &Batch{Schema: schema, NumRows: len(nodes)}
+	for _, f := range schema {
+		col := Column{Valid: make([]bool, len(nodes))}
+		switch f.Type {
+		case Int64:
+			col.Values = make([]int64, len(nodes))
+		case Uint64:
+			col.Values = make([]uint64, len(nodes))
+		case Float64:
+			col.Values = make([]float64, len(nodes))
+		case String:
+			col.Values = make([]string, len(nodes))
+		case Bool:
+			col.Values = make([]bool, len(nodes))
+		case Timestamp:
+			col.Values = make([]time.Time, len(nodes))
+		case Binary:
+			col.Values = make([][]byte, len(nodes))
+		}
+
+		for i, n := range nodes {
+			v := n.Data[f.Name]
+			if v == nil {
+				continue
+			}
+			col.Valid[i] = true
+			if err := setValue(col.Values, i, v); err != nil {
+				return nil, fmt.Errorf("column %s: %w", f.Name, err)
+			}
+		}
+		batch.Columns = append(batch.Columns, col)
+	}
+	return batch, nil
+}
+
+// setValue stores v at index i of the typed slice values.
+func setValue(values interface{}, i int, v interface{}) error {
+	switch vs := values.(type) {
+	case []int64:
+		n, ok := asInt64(v)
+		if !ok {
+			return fmt.Errorf("cannot store %T as int64", v)
+		}
+		vs[i] = n
+	case []uint64:
+		switch x := v.(type) {
+		case uint:
+			vs[i] = uint64(x)
+		case uint64:
+			vs[i] = x
+		default:
+			return fmt.Errorf("cannot store %T as uint64", v)
+		}
+	case []float64:
+		if n, ok := asInt64(v); ok {
+			vs[i] = float64(n)
+			return nil
+		}
+		switch x := v.(type) {
+		case float32:
+			vs[i] = float64(x)
+		case float64:
+			vs[i] = x
+		case uint:
+			vs[i] = float64(x)
+		case uint64:
+			vs[i] = float64(x)
+		default:
+			return fmt.Errorf("cannot store %T as float64", v)
+		}
+	case []string:
+		vs[i] = v.(string)
+	case []bool:
+		vs[i] = v.(bool)
+	case []time.Time:
+		vs[i] = v.(time.Time)
+	case [][]byte:
+		vs[i] = v.([]byte)
+	}
+	return nil
+}
+
+// asInt64 converts integer types that fit in an int64.
+func asInt64(v interface{}) (int64, bool) {
+	switch x := v.(type) {
+	case int:
+		return int64(x), true
+	case int8:
+		return int64(x), true
+	case int16:
+		return int64(x), true
+	case int32:
+		return int64(x), true
+	case int64:
+		return x, true
+	case uint8:
+		return int64(x), true
+	case uint16:
+		return int64(x), true
+	case uint32:
+		return int64(x), true
+	}
+	return 0, false
+}
+
+// FromBatches converts record batches back into a list, one node per row.
+// NULL entries become nil values in Data.
+func FromBatches(batches []*Batch) (*linkedlist.LinkedList, error) {
+	ll := linkedlist.New()
+	for _, b := range batches {
+		if len(b.Columns) != len(b.Schema) {
+			return nil, fmt.Errorf("batch has %d columns for %d fields", len(b.Columns), len(b.Schema))
+		}
+		for row := 0; row < b.NumRows; row++ {
+			data := make(map[string]interface{}, len(b.Schema))
+			for c, f := range b.Schema {
+				col := b.Columns[c]
+				if row >= len(col.Valid) || !col.Valid[row] {
+					data[f.Name] = nil
+					continue
+				}
+				data[f.Name] = valueAt(col.Values, row)
+			}
+			ll.Append(data)
+		}
+	}
+	return ll, nil
+}
+
+// valueAt returns element i of the typed slice values.
+func valueAt(values interface{}, i int) interface{} {
+	switch vs := values.(type) {
+	case []int64:
+		return vs[i]
+	case []uint64:
+		return vs[i]
+	case []float64:
+		return vs[i]
+	case []string:
+		return vs[i]
+	case []bool:
+		return vs[i]
+	case []time.Time:
+		return vs[i]
+	case [][]byte:
+		return vs[i]
+	}
+	return nil
+}
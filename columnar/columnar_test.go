@@ -0,0 +1,101 @@
+package columnar
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+func TestToBatches_RoundTrip(t *testing.T) {
+	at := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	ll := linkedlist.New()
+	ll.Append(map[string]interface{}{"id": 1, "price": int64(10), "name": "a", "at": at, "ok": true})
+	ll.Append(map[string]interface{}{"id": 2, "price": 2.5, "name": nil, "at": at, "ok": false})
+	ll.Append(map[string]interface{}{"id": 3, "price": nil, "name": "c", "at": at})
+
+	batches, err := ToBatches(ll, 2)
+	if err != nil {
+		t.Fatalf("ToBatches failed: %v", err)
+	}
+	if len(batches) != 2 || batches[0].NumRows != 2 || batches[1].NumRows != 1 {
+		t.Fatalf("Unexpected batch layout: %d batches", len(batches))
+	}
+
+	schema := batches[0].Schema
+	want := []Field{
+		{Name: "at", Type: Timestamp},
+		{Name: "id", Type: Int64},
+		{Name: "name", Type: String, Nullable: true},
+		{Name: "ok", Type: Bool, Nullable: true},
+		{Name: "price", Type: Float64, Nullable: true},
+	}
+	for i := range want {
+		if schema[i] != want[i] {
+			t.Errorf("Field %d: expected %+v, got %+v", i, want[i], schema[i])
+		}
+	}
+	if ids := batches[0].Columns[1].Values.([]int64); ids[0] != 1 || ids[1] != 2 {
+		t.Errorf("Unexpected id column: %v", ids)
+	}
+
+	back, err := FromBatches(batches)
+	if err != nil {
+		t.Fatalf("FromBatches failed: %v", err)
+	}
+	if back.Len() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", back.Len())
+	}
+	first := back.First().Data
+	if first["id"] != int64(1) || first["price"] != 10.0 || first["name"] != "a" {
+		t.Errorf("Unexpected first row: %+v", first)
+	}
+	last := back.Last().Data
+	if last["price"] != nil || last["ok"] != nil {
+		t.Errorf("Expected NULLs in last row, got %+v", last)
+	}
+}
+
+func TestToBatches_MixedTypes(t *testing.T) {
+	ll := linkedlist.New()
+	ll.Append(map[string]interface{}{"v": 1})
+	ll.Append(map[string]interface{}{"v": "one"})
+	if _, err := ToBatches(ll, 0); err == nil {
+		t.Error("Expected error for mixed column types")
+	}
+}
+
+func TestToBatches_UnsupportedType(t *testing.T) {
+	ll := linkedlist.New()
+	ll.Append(map[string]interface{}{"v": struct{}{}})
+	if _, err := ToBatches(ll, 0); err == nil {
+		t.Error("Expected error for unsupported type")
+	}
+}
+
+func TestToBatches_Uint64(t *testing.T) {
+	ll := linkedlist.New()
+	ll.Append(map[string]interface{}{"u": uint64(math.MaxUint64), "m": uint(1)})
+	ll.Append(map[string]interface{}{"u": uint64(7), "m": int64(-1)})
+
+	batches, err := ToBatches(ll, 0)
+	if err != nil {
+		t.Fatalf("ToBatches failed: %v", err)
+	}
+	schema := batches[0].Schema
+	if schema[0].Type != Float64 || schema[1].Type != Uint64 {
+		t.Errorf("Expected m Float64 and u Uint64, got %+v", schema)
+	}
+	if u := batches[0].Columns[1].Values.([]uint64); u[0] != math.MaxUint64 {
+		t.Errorf("Expected MaxUint64 to survive, got %v", u[0])
+	}
+
+	back, err := FromBatches(batches)
+	if err != nil {
+		t.Fatalf("FromBatches failed: %v", err)
+	}
+	if back.First().Data["u"] != uint64(math.MaxUint64) {
+		t.Errorf("Unexpected round trip: %+v", back.First().Data)
+	}
+}
@@ -0,0 +1,103 @@
+package linkedlist
+
+import "fmt"
+
+// AddColumn adds column to every row that does not have it yet. value is
+// either the default to store or a func(*Node) interface{} computing the
+// value for each row. Rows that already hold the column keep their value,
+// which makes AddColumn suitable for aligning lists loaded from different
+// schema versions.
+func (ll *LinkedList) AddColumn(column string, value interface{}) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	fn, computed := value.(func(*Node) interface{})
+	for n := ll.front(); n != nil; n = n.succ() {
+		if _, ok := n.Data[column]; ok {
+			continue
+		}
+		v := value
+		if computed {
+			v = fn(n)
+		}
+		n.own()
+		if n.Data == nil {
+			n.Data = make(map[string]interface{})
+		}
+		n.Data[column] = v
+	}
+	ll.record("update", ll.len, "AddColumn %s", column)
+	return nil
+}
+
+// DropColumn removes column from every row.
+func (ll *LinkedList) DropColumn(column string) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	for n := ll.front(); n != nil; n = n.succ() {
+		if _, ok := n.Data[column]; ok {
+			n.own()
+			delete(n.Data, column)
+		}
+	}
+	ll.record("update", ll.len, "DropColumn %s", column)
+	return nil
+}
+
+// AddComputedColumn sets column name in every row to the value of an
+// expression over the row's columns, such as "price * quantity" or
+// "concat(first, ' ', last)". Expressions use the syntax of Where extended
+// with +, -, *, / and % on numbers, || for string concatenation, and the
+// functions concat, coalesce, upper, lower, trim, length, substr, abs,
+// round, floor and ceil. Integer arithmetic stays int64 except for /, which
+// gives float64. NULL operands give NULL, except in concat and coalesce.
+// Conditions give booleans. If any row fails to evaluate, the list is left
+// unchanged.
+func (ll *LinkedList) AddComputedColumn(name, expression string) error {
+	e, err := parseExpr(expression)
+	if err != nil {
+		return fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	var nodes []*Node
+	var values []interface{}
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		v, err := e.eval(n.Data)
+		if err != nil {
+			return fmt.Errorf("row %d: error evaluating %q: %w", pos, expression, err)
+		}
+		nodes = append(nodes, n)
+		values = append(values, v)
+	}
+	for i, n := range nodes {
+		n.Set(name, values[i])
+	}
+	ll.record("update", len(nodes), "AddComputedColumn %s = %s", name, expression)
+	return nil
+}
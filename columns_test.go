@@ -0,0 +1,117 @@
+package linkedlist
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAddColumn_Default(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1})
+	ll.Append(map[string]interface{}{"id": 2, "status": "closed"})
+
+	if err := ll.AddColumn("status", "open"); err != nil {
+		t.Fatalf("AddColumn failed: %v", err)
+	}
+	if ll.First().Data["status"] != "open" {
+		t.Errorf("Expected default status, got %v", ll.First().Data["status"])
+	}
+	if ll.Last().Data["status"] != "closed" {
+		t.Errorf("Expected existing status to be kept, got %v", ll.Last().Data["status"])
+	}
+}
+
+func TestAddColumn_Computed(t *testing.T) {
+	ll := peopleList()
+	err := ll.AddColumn("label", func(n *Node) interface{} {
+		return n.Data["name"].(string) + "@" + n.Data["city"].(string)
+	})
+	if err != nil {
+		t.Fatalf("AddColumn failed: %v", err)
+	}
+	if ll.First().Data["label"] != "Alice@NY" || ll.Last().Data["label"] != "Dan@NY" {
+		t.Errorf("Unexpected labels: %v, %v", ll.First().Data["label"], ll.Last().Data["label"])
+	}
+}
+
+func TestDropColumn(t *testing.T) {
+	ll := peopleList()
+	view := ll.Freeze()
+	if err := ll.DropColumn("city"); err != nil {
+		t.Fatalf("DropColumn failed: %v", err)
+	}
+	for n := ll.First(); n != nil; n = n.next {
+		if _, ok := n.Data["city"]; ok {
+			t.Fatalf("Expected city to be dropped, got %v", n.Data)
+		}
+	}
+	if view.First().Data["city"] != "NY" {
+		t.Error("Expected frozen view to keep the column")
+	}
+	if err := view.DropColumn("city"); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestAddComputedColumn(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"first": "Ada", "last": "Lovelace", "price": 2.5, "quantity": 4, "discount": nil})
+	ll.Append(map[string]interface{}{"first": "Alan", "last": nil, "price": 10, "quantity": 3, "discount": 5})
+
+	exprs := []struct {
+		name, expr string
+		want       []interface{}
+	}{
+		{"total", "price * quantity", []interface{}{10.0, int64(30)}},
+		{"net", "price * quantity - coalesce(discount, 0)", []interface{}{10.0, int64(25)}},
+		{"name", "concat(first, ' ', last)", []interface{}{"Ada Lovelace", "Alan "}},
+		{"joined", "first || '-' || last", []interface{}{"Ada-Lovelace", nil}},
+		{"upper", "upper(substr(first, 1, 2))", []interface{}{"AD", "AL"}},
+		{"half", "round(quantity / 3, 2)", []interface{}{1.33, 1.0}},
+		{"neg", "-quantity % 2", []interface{}{int64(0), int64(-1)}},
+		{"big", "price * quantity > 20", []interface{}{false, true}},
+	}
+	for _, c := range exprs {
+		if err := ll.AddComputedColumn(c.name, c.expr); err != nil {
+			t.Fatalf("AddComputedColumn(%q) failed: %v", c.expr, err)
+		}
+		got := []interface{}{ll.First().Data[c.name], ll.Last().Data[c.name]}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Expected %v for %q, got %v", c.want, c.expr, got)
+		}
+	}
+}
+
+func TestAddComputedColumnErrors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"a": 1, "b": 0, "s": "x", "big": int64(math.MaxInt64), "small": int64(math.MinInt64)})
+
+	for _, bad := range []string{"a +", "nosuch(a)", "upper(a, b)", "concat()"} {
+		if err := ll.AddComputedColumn("c", bad); err == nil {
+			t.Errorf("Expected parse error for %q, got nil", bad)
+		}
+	}
+	for _, bad := range []string{"a / b", "s * 2", "big + a", "small - a", "big * 2", "small * -1"} {
+		if err := ll.AddComputedColumn("c", bad); err == nil {
+			t.Errorf("Expected evaluation error for %q, got nil", bad)
+		}
+	}
+	if _, ok := ll.First().Data["c"]; ok {
+		t.Error("Expected no column added on error")
+	}
+}
+
+func TestWhereWithArithmetic(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"price": 5, "quantity": 3})
+	ll.Append(map[string]interface{}{"price": 2, "quantity": 3})
+
+	res, err := ll.Where("price * quantity >= 10 AND lower('X') = 'x'")
+	if err != nil {
+		t.Fatalf("Where failed: %v", err)
+	}
+	if res.Len() != 1 || res.First().Data["price"] != 5 {
+		t.Errorf("Expected only the price 5 row, got %d rows", res.Len())
+	}
+}
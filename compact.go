@@ -0,0 +1,272 @@
+package linkedlist
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Blob layout flags.
+const (
+	blobRaw      byte = 0
+	blobDeflated byte = 1
+)
+
+// Value type tags used by the compact row encoding.
+const (
+	tagNil byte = iota
+	tagFalse
+	tagTrue
+	tagInt
+	tagInt64
+	tagUint64
+	tagFloat64
+	tagString
+	tagBytes
+	tagTime
+)
+
+// errNotCompactable reports a Data value the compact encoding cannot hold.
+var errNotCompactable = errors.New("value type not supported by compact encoding")
+
+// Compact encodes the Data of every resident node into a compressed binary
+// blob and releases the map, reducing memory for rarely read snapshots. A
+// compacted node is decoded again the first time it is reached through the
+// list's accessors (First, Last, Next, Nodes) or operations. Nodes holding
+// values other than nil, bool, integers, floats, strings, []byte and
+// time.Time are left as they are, as are frozen views, whose nodes may be
+// read concurrently. Compact returns the number of nodes it compacted.
+func (ll *LinkedList) Compact() int {
+	if ll == nil || ll.frozen {
+		return 0
+	}
+	compacted := 0
+	for n := ll.head; n != nil; n = n.next {
+		if n.packed != nil || n.Data == nil {
+			continue
+		}
+		blob, err := encodeRow(n.Data)
+		if err != nil {
+			continue
+		}
+		// Check the blob decodes now, so reading the node later cannot fail
+		// on a row the encoding mishandles.
+		if _, err := decodeRow(blob); err != nil {
+			continue
+		}
+		n.packed = blob
+		n.Data = nil
+		compacted++
+	}
+	return compacted
+}
+
+// expand decodes a compacted node back into Data. On failure the node is
+// left compacted, with nil Data, and the error is returned. Nodes of frozen
+// views are expanded by Freeze and never written here, so concurrent
+// readers do not race.
+func (n *Node) expand() error {
+	if n == nil || n.packed == nil || n.frozen {
+		return nil
+	}
+	data, err := decodeRow(n.packed)
+	if err != nil {
+		return fmt.Errorf("linkedlist: corrupt compacted node: %w", err)
+	}
+	n.Data = data
+	n.packed = nil
+	return nil
+}
+
+// front returns the resident head, expanded. A head that cannot be decoded
+// is returned with nil Data; methods with an error result use each, which
+// reports the failure.
+func (ll *LinkedList) front() *Node {
+	if ll == nil {
+		return nil
+	}
+	ll.head.expand()
+	return ll.head
+}
+
+// succ returns the node after n, expanded like front.
+func (n *Node) succ() *Node {
+	n.next.expand()
+	return n.next
+}
+
+// encodeRow serialises a row and deflates it when that makes it smaller.
+func encodeRow(data map[string]interface{}) ([]byte, error) {
+	var raw bytes.Buffer
+	raw.WriteByte(blobRaw)
+	writeUvarint(&raw, uint64(len(data)))
+	for k, v := range data {
+		writeString(&raw, k)
+		if err := writeValue(&raw, v); err != nil {
+			return nil, err
+		}
+	}
+
+	var deflated bytes.Buffer
+	deflated.WriteByte(blobDeflated)
+	fw, _ := flate.NewWriter(&deflated, flate.BestCompression)
+	fw.Write(raw.Bytes()[1:])
+	fw.Close()
+
+	if deflated.Len() < raw.Len() {
+		return bytes.Clone(deflated.Bytes()), nil
+	}
+	return bytes.Clone(raw.Bytes()), nil
+}
+
+// decodeRow reverses encodeRow.
+func decodeRow(blob []byte) (map[string]interface{}, error) {
+	if len(blob) == 0 {
+		return nil, errors.New("empty blob")
+	}
+	body := blob[1:]
+	if blob[0] == blobDeflated {
+		var err error
+		body, err = io.ReadAll(flate.NewReader(bytes.NewReader(body)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r := bytes.NewReader(body)
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{}, count)
+	for i := uint64(0); i < count; i++ {
+		k, err := readString(r)
+		if err != nil {
+			return nil, err
+		}
+		v, err := readValue(r)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = v
+	}
+	return data, nil
+}
+
+func writeUvarint(buf *bytes.Buffer, x uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	buf.Write(tmp[:binary.PutUvarint(tmp[:], x)])
+}
+
+func writeVarint(buf *bytes.Buffer, x int64) {
+	var tmp [binary.MaxVarintLen64]byte
+	buf.Write(tmp[:binary.PutVarint(tmp[:], x)])
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUvarint(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func writeValue(buf *bytes.Buffer, v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		buf.WriteByte(tagNil)
+	case bool:
+		if x {
+			buf.WriteByte(tagTrue)
+		} else {
+			buf.WriteByte(tagFalse)
+		}
+	case int:
+		buf.WriteByte(tagInt)
+		writeVarint(buf, int64(x))
+	case int64:
+		buf.WriteByte(tagInt64)
+		writeVarint(buf, x)
+	case uint64:
+		buf.WriteByte(tagUint64)
+		writeUvarint(buf, x)
+	case float64:
+		buf.WriteByte(tagFloat64)
+		var tmp [8]byte
+		binary.LittleEndian.PutUint64(tmp[:], math.Float64bits(x))
+		buf.Write(tmp[:])
+	case string:
+		buf.WriteByte(tagString)
+		writeString(buf, x)
+	case []byte:
+		buf.WriteByte(tagBytes)
+		writeString(buf, string(x))
+	case time.Time:
+		b, err := x.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(tagTime)
+		writeString(buf, string(b))
+	default:
+		return errNotCompactable
+	}
+	return nil
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if n > uint64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return string(b), err
+}
+
+func readValue(r *bytes.Reader) (interface{}, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case tagNil:
+		return nil, nil
+	case tagFalse:
+		return false, nil
+	case tagTrue:
+		return true, nil
+	case tagInt:
+		x, err := binary.ReadVarint(r)
+		return int(x), err
+	case tagInt64:
+		return binary.ReadVarint(r)
+	case tagUint64:
+		return binary.ReadUvarint(r)
+	case tagFloat64:
+		var tmp [8]byte
+		if _, err := io.ReadFull(r, tmp[:]); err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(tmp[:])), nil
+	case tagString:
+		return readString(r)
+	case tagBytes:
+		s, err := readString(r)
+		return []byte(s), err
+	case tagTime:
+		s, err := readString(r)
+		if err != nil {
+			return nil, err
+		}
+		var t time.Time
+		err = t.UnmarshalBinary([]byte(s))
+		return t, err
+	}
+	return nil, fmt.Errorf("unknown value tag %d", tag)
+}
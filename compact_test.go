@@ -0,0 +1,127 @@
+package linkedlist
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCompact_RoundTrip(t *testing.T) {
+	at := time.Date(2024, 2, 3, 4, 5, 6, 7, time.UTC)
+	row := map[string]interface{}{
+		"id": int64(-7), "n": 3, "u": uint64(9), "f": 1.25, "s": "hello",
+		"b": []byte("raw"), "ok": true, "no": false, "nil": nil, "at": at,
+	}
+	ll := New()
+	ll.Append(row)
+	ll.Append(map[string]interface{}{"id": int64(2)})
+
+	if got := ll.Compact(); got != 2 {
+		t.Fatalf("Expected 2 compacted nodes, got %d", got)
+	}
+	if ll.head.Data != nil || ll.head.packed == nil {
+		t.Fatal("Expected head Data to be released after Compact")
+	}
+
+	first := ll.First()
+	if !DefaultRowEqual(first.Data, row) {
+		t.Errorf("Row mismatch after expand: %+v", first.Data)
+	}
+	if _, ok := first.Data["n"].(int); !ok {
+		t.Errorf("Expected int type preserved, got %T", first.Data["n"])
+	}
+	if !first.Data["at"].(time.Time).Equal(at) {
+		t.Errorf("Expected time %v, got %v", at, first.Data["at"])
+	}
+	if string(first.Data["b"].([]byte)) != "raw" {
+		t.Errorf("Expected bytes preserved, got %v", first.Data["b"])
+	}
+}
+
+func TestCompact_OperationsExpandNodes(t *testing.T) {
+	ll := peopleList()
+	ll.Compact()
+
+	got, err := ll.Where("city = 'NY' AND age > 30")
+	if err != nil {
+		t.Fatalf("Where failed: %v", err)
+	}
+	if got.Len() != 1 || got.First().Data["name"] != "Alice" {
+		t.Errorf("Unexpected Where result on compacted list: %v", names(got))
+	}
+
+	ll.Compact()
+	ll.ResetIterator()
+	count := 0
+	for node := ll.Next(); node != nil; node = ll.Next() {
+		if node.Data == nil {
+			t.Fatal("Expected Next to return expanded nodes")
+		}
+		count++
+	}
+	if count != 4 {
+		t.Errorf("Expected 4 nodes, got %d", count)
+	}
+}
+
+func TestCompact_SkipsUnsupportedValues(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"nested": map[string]interface{}{"a": 1}})
+	if got := ll.Compact(); got != 0 {
+		t.Errorf("Expected unsupported node to stay expanded, compacted %d", got)
+	}
+	if ll.head.Data == nil {
+		t.Error("Expected Data to be kept")
+	}
+}
+
+func TestCompact_ShrinksRepetitiveRows(t *testing.T) {
+	long := "lorem ipsum dolor sit amet lorem ipsum dolor sit amet lorem ipsum dolor sit amet"
+	blob, err := encodeRow(map[string]interface{}{"text": long})
+	if err != nil {
+		t.Fatalf("encodeRow failed: %v", err)
+	}
+	if len(blob) >= len(long) || blob[0] != blobDeflated {
+		t.Errorf("Expected deflated blob smaller than %d bytes, got %d", len(long), len(blob))
+	}
+}
+
+func TestCompact_CorruptNodeReturnsError(t *testing.T) {
+	ll := peopleList()
+	ll.Compact()
+	ll.head.packed = []byte{blobRaw, 0xff}
+
+	if err := ll.each(func(*Node) error { return nil }); err == nil {
+		t.Error("Expected each to report the corrupt node")
+	}
+	ll.ResetIterator()
+	if ll.Next() != nil || ll.Err() == nil {
+		t.Errorf("Expected Next to stop with an error, got %v", ll.Err())
+	}
+}
+
+func TestCompact_FrozenViewConcurrentReads(t *testing.T) {
+	ll := peopleList()
+	ll.Compact()
+	view := ll.Freeze()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := len(view.Nodes()); got != 4 {
+				t.Errorf("Expected 4 nodes, got %d", got)
+			}
+			for _, n := range view.Nodes() {
+				if n.Data == nil {
+					t.Error("Expected view nodes to be expanded")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if ll.head.packed == nil {
+		t.Error("Expected Freeze to leave the original compacted")
+	}
+}
@@ -0,0 +1,116 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CompatReport describes how the list's rows would scan into a struct type.
+type CompatReport struct {
+	// Mapped maps each column that feeds a field to that field's name.
+	Mapped map[string]string
+	// Failures lists the first failing conversion for each field.
+	Failures []CompatFailure
+	// UnmappedColumns are columns no field reads, sorted by name.
+	UnmappedColumns []string
+	// UnmappedFields are exported fields no column feeds, in field order.
+	UnmappedFields []string
+}
+
+// CompatFailure is a value that could not be converted to its field.
+type CompatFailure struct {
+	Column string
+	Field  string
+	Row    int // 1-based position of the first failing row
+	Err    error
+}
+
+// OK reports whether every row would scan without error and every column and
+// field is mapped.
+func (r *CompatReport) OK() bool {
+	return len(r.Failures) == 0 && len(r.UnmappedColumns) == 0 && len(r.UnmappedFields) == 0
+}
+
+// CheckCompatibility scans every row into a throwaway value of dest's type,
+// where dest is a reflect.Type, a struct or a pointer to a struct, and
+// reports which columns map to which fields, which values fail to convert
+// and what is left unmapped. Run it once at startup to catch schema drift.
+func (ll *LinkedList) CheckCompatibility(dest interface{}) (*CompatReport, error) {
+	t, ok := dest.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(dest)
+	}
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.New("destination must be a struct type")
+	}
+
+	report := &CompatReport{Mapped: make(map[string]string)}
+	sc := ll.scanner()
+	fieldUsed := make([]bool, t.NumField())
+	fieldFailed := make([]bool, t.NumField())
+	columns := make(map[string]bool)
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		for k := range n.Data {
+			columns[k] = true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := fieldColumnName(field)
+			column, value, found := lookupField(n.Data, name)
+			if !found {
+				continue
+			}
+			fieldUsed[i] = true
+			report.Mapped[column] = field.Name
+			if value == nil || fieldFailed[i] {
+				continue
+			}
+			if err := sc.setStructField(reflect.New(field.Type).Elem(), field, value); err != nil {
+				fieldFailed[i] = true
+				report.Failures = append(report.Failures, CompatFailure{Column: column, Field: field.Name, Row: pos, Err: err})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	for c := range columns {
+		if _, ok := report.Mapped[c]; !ok {
+			report.UnmappedColumns = append(report.UnmappedColumns, c)
+		}
+	}
+	sort.Strings(report.UnmappedColumns)
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() && !fieldUsed[i] {
+			report.UnmappedFields = append(report.UnmappedFields, t.Field(i).Name)
+		}
+	}
+	return report, nil
+}
+
+// lookupField finds the column StructScan would read for a field named name:
+// an exact match, or else one equal ignoring case.
+func lookupField(data map[string]interface{}, name string) (string, interface{}, bool) {
+	if v, ok := data[name]; ok {
+		return name, v, true
+	}
+	for k, v := range data {
+		if strings.EqualFold(k, name) {
+			return k, v, true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,57 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+type compatPerson struct {
+	Name  string `db:"name"`
+	Age   int    `db:"age"`
+	Email string `db:"email"`
+	note  string
+}
+
+func TestCheckCompatibility_Report(t *testing.T) {
+	ll := peopleList()
+	ll.Append(map[string]interface{}{"name": "Eve", "age": "unknown", "city": "SF"})
+
+	report, err := ll.CheckCompatibility(compatPerson{})
+	if err != nil {
+		t.Fatalf("CheckCompatibility failed: %v", err)
+	}
+	if report.OK() {
+		t.Error("Expected report not to be OK")
+	}
+	if report.Mapped["name"] != "Name" || report.Mapped["age"] != "Age" || len(report.Mapped) != 2 {
+		t.Errorf("Unexpected mapping: %v", report.Mapped)
+	}
+	if len(report.Failures) != 1 || report.Failures[0].Field != "Age" || report.Failures[0].Row != 5 {
+		t.Errorf("Unexpected failures: %+v", report.Failures)
+	}
+	if !reflect.DeepEqual(report.UnmappedColumns, []string{"city"}) {
+		t.Errorf("Expected unmapped columns [city], got %v", report.UnmappedColumns)
+	}
+	if !reflect.DeepEqual(report.UnmappedFields, []string{"Email"}) {
+		t.Errorf("Expected unmapped fields [Email], got %v", report.UnmappedFields)
+	}
+}
+
+func TestCheckCompatibility_TypeArguments(t *testing.T) {
+	type row struct{ Name string }
+	ll := New()
+	ll.Append(map[string]interface{}{"NAME": "x"})
+
+	for _, dest := range []interface{}{row{}, &row{}, reflect.TypeOf(row{})} {
+		report, err := ll.CheckCompatibility(dest)
+		if err != nil {
+			t.Fatalf("CheckCompatibility(%T) failed: %v", dest, err)
+		}
+		if !report.OK() || report.Mapped["NAME"] != "Name" {
+			t.Errorf("Expected clean report for %T, got %+v", dest, report)
+		}
+	}
+	if _, err := ll.CheckCompatibility(42); err == nil {
+		t.Error("Expected error for non-struct destination")
+	}
+}
@@ -0,0 +1,70 @@
+package linkedlist
+
+import "sync/atomic"
+
+// ConcurrentIndex maps the values of one or more key columns to rows of a
+// frozen snapshot of a list. Lookups take no locks and are safe from any
+// number of goroutines while a writer calls Refresh.
+type ConcurrentIndex struct {
+	columns []string
+	snap    atomic.Pointer[indexSnapshot]
+}
+
+// indexSnapshot is one immutable generation of a ConcurrentIndex.
+type indexSnapshot struct {
+	list *LinkedList
+	rows map[string][]*Node
+}
+
+// ConcurrentIndex indexes the list's rows, spilled ones included, by the
+// given columns, such as ("tenant_id", "id") for a composite key. The index
+// does not follow later changes to ll; call Refresh to rebuild it.
+func (ll *LinkedList) ConcurrentIndex(columns ...string) *ConcurrentIndex {
+	ci := &ConcurrentIndex{columns: columns}
+	ci.Refresh(ll)
+	return ci
+}
+
+// Refresh rebuilds the index from ll and publishes it atomically. Readers
+// see either the old or the new index, never a mix. The snapshot holds its
+// own nodes, and ll's rows are marked shared before it is published, so a
+// later Set on ll copies the row instead of writing under readers. Refresh
+// must be called by the goroutine that writes to ll.
+func (ci *ConcurrentIndex) Refresh(ll *LinkedList) {
+	frozen := ll.Freeze()
+	rows := make(map[string][]*Node, frozen.Len())
+	for n := frozen.front(); n != nil; n = n.succ() {
+		k := rowKey(n.Data, ci.columns)
+		rows[k] = append(rows[k], n)
+	}
+	ci.snap.Store(&indexSnapshot{list: frozen, rows: rows})
+}
+
+// Get returns the first row whose key columns equal values, given in the
+// order the columns were. Numbers match regardless of Go type, as in
+// GroupBy.
+func (ci *ConcurrentIndex) Get(values ...interface{}) (*Node, bool) {
+	rows := ci.snap.Load().rows[EncodeKey(values...)]
+	if len(rows) == 0 {
+		return nil, false
+	}
+	return rows[0], true
+}
+
+// GetAll returns every row whose key columns equal values, in list order.
+// The slice must not be modified.
+func (ci *ConcurrentIndex) GetAll(values ...interface{}) []*Node {
+	return ci.snap.Load().rows[EncodeKey(values...)]
+}
+
+// Len returns the number of distinct keys indexed.
+func (ci *ConcurrentIndex) Len() int {
+	return len(ci.snap.Load().rows)
+}
+
+// List returns a frozen view of the snapshot the index currently serves.
+// Each call gets its own view sharing the snapshot's nodes, so goroutines
+// can iterate their views concurrently without locking.
+func (ci *ConcurrentIndex) List() *LinkedList {
+	return ci.snap.Load().list.Freeze()
+}
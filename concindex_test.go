@@ -0,0 +1,143 @@
+package linkedlist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentIndexGet(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": int64(1), "name": "Alice"})
+	ll.Append(map[string]interface{}{"id": int64(2), "name": "Bob"})
+	ll.Append(map[string]interface{}{"id": int64(2), "name": "Bobby"})
+
+	ci := ll.ConcurrentIndex("id")
+	if ci.Len() != 2 {
+		t.Errorf("Expected 2 keys, got %d", ci.Len())
+	}
+	n, ok := ci.Get(1)
+	if !ok || n.Data["name"] != "Alice" {
+		t.Errorf("Expected Alice for id 1, got %v", n)
+	}
+	if all := ci.GetAll(2); len(all) != 2 || all[1].Data["name"] != "Bobby" {
+		t.Errorf("Expected Bob and Bobby for id 2, got %d rows", len(all))
+	}
+	if _, ok := ci.Get(3); ok {
+		t.Error("Expected no row for id 3")
+	}
+}
+
+func TestConcurrentIndexIgnoresWritesUntilRefresh(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	ci := ll.ConcurrentIndex("id")
+
+	ll.Append(map[string]interface{}{"id": 2, "name": "Bob"})
+	ll.First().Set("name", "Alicia")
+	if _, ok := ci.Get(2); ok {
+		t.Error("Expected id 2 to be missing before Refresh")
+	}
+	if n, _ := ci.Get(1); n.Data["name"] != "Alice" {
+		t.Errorf("Expected snapshot to keep Alice, got %v", n.Data["name"])
+	}
+
+	ci.Refresh(ll)
+	if n, ok := ci.Get(2); !ok || n.Data["name"] != "Bob" {
+		t.Errorf("Expected Bob after Refresh, got %v", n)
+	}
+	if !ci.List().IsFrozen() {
+		t.Error("Expected the served list to be frozen")
+	}
+}
+
+func TestConcurrentIndexParallelReads(t *testing.T) {
+	ll := New()
+	for i := 0; i < 100; i++ {
+		ll.Append(map[string]interface{}{"id": i})
+	}
+	ci := ll.ConcurrentIndex("id")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if _, ok := ci.Get(i % 100); !ok {
+					t.Errorf("Expected id %d to be indexed", i%100)
+					return
+				}
+			}
+		}()
+	}
+	for i := 100; i < 150; i++ {
+		ll.Append(map[string]interface{}{"id": i})
+		ci.Refresh(ll)
+	}
+	wg.Wait()
+	if ci.Len() != 150 {
+		t.Errorf("Expected 150 keys, got %d", ci.Len())
+	}
+}
+
+func TestConcurrentIndexReadsDuringSet(t *testing.T) {
+	ll := New()
+	for i := 0; i < 10; i++ {
+		ll.Append(map[string]interface{}{"id": i, "name": "a"})
+	}
+	ci := ll.ConcurrentIndex("id")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				n, ok := ci.Get(i % 10)
+				if !ok {
+					t.Errorf("Expected id %d to be indexed", i%10)
+					return
+				}
+				_ = n.Data["name"]
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		for _, n := range ll.Nodes() {
+			n.Set("name", i)
+		}
+		if i%10 == 0 {
+			ci.Refresh(ll)
+		}
+	}
+	wg.Wait()
+}
+
+func TestConcurrentIndexListConcurrentReaders(t *testing.T) {
+	ll := New()
+	for i := 0; i < 50; i++ {
+		ll.Append(map[string]interface{}{"id": i})
+	}
+	ci := ll.ConcurrentIndex("id")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 2; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := 0; round < 20; round++ {
+				list := ci.List()
+				list.ResetIterator()
+				count := 0
+				for n := list.Next(); n != nil; n = list.Next() {
+					count++
+				}
+				if count != 50 {
+					t.Errorf("Expected 50 rows from List, got %d", count)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,191 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConverterFunc converts a Data value to a destination type registered with
+// RegisterConverter or WithConverter. It returns a value assignable or
+// convertible to that type, or nil to leave the field at its zero value.
+type ConverterFunc func(value interface{}) (interface{}, error)
+
+// globalConverters holds converters registered with RegisterConverter.
+var globalConverters struct {
+	sync.RWMutex
+	m map[reflect.Type]ConverterFunc
+}
+
+// namedConverters holds converters registered with RegisterNamedConverter.
+var namedConverters struct {
+	sync.RWMutex
+	m map[string]ConverterFunc
+}
+
+// ErrUnknownConverter is returned when a field's convert= tag option names a
+// converter that was never registered.
+var ErrUnknownConverter = errors.New("linkedlist: unknown converter")
+
+// RegisterNamedConverter registers fn under name for use in struct tags:
+//
+//	Price decimal.Decimal `db:"price,convert=centsToDecimal"`
+//
+// A named converter on a field takes precedence over converters registered
+// for the field's type. Passing a nil fn removes the converter.
+func RegisterNamedConverter(name string, fn ConverterFunc) {
+	namedConverters.Lock()
+	defer namedConverters.Unlock()
+	if fn == nil {
+		delete(namedConverters.m, name)
+		return
+	}
+	if namedConverters.m == nil {
+		namedConverters.m = make(map[string]ConverterFunc)
+	}
+	namedConverters.m[name] = fn
+}
+
+// RegisterConverter makes every scan into a field of destType, or of
+// *destType, go through fn before generic conversion. Register converters
+// for domain types such as Money or PhoneNumber once, at program start.
+// Passing a nil fn removes the converter.
+func RegisterConverter(destType reflect.Type, fn ConverterFunc) {
+	globalConverters.Lock()
+	defer globalConverters.Unlock()
+	if fn == nil {
+		delete(globalConverters.m, destType)
+		return
+	}
+	if globalConverters.m == nil {
+		globalConverters.m = make(map[reflect.Type]ConverterFunc)
+	}
+	globalConverters.m[destType] = fn
+}
+
+// WithConverter is RegisterConverter for scans through this list only. It
+// takes precedence over a global converter for the same type.
+func WithConverter(destType reflect.Type, fn ConverterFunc) Option {
+	return func(ll *LinkedList) {
+		if ll.converters == nil {
+			ll.converters = make(map[reflect.Type]ConverterFunc)
+		}
+		ll.converters[destType] = fn
+	}
+}
+
+// scanner carries the per-list settings used while scanning rows into
+// structs. A nil *scanner uses only global converters and does not log.
+type scanner struct {
+	logger       *slog.Logger
+	converters   map[reflect.Type]ConverterFunc
+	assumeLoc    *time.Location
+	convertLoc   *time.Location
+	lenientBools bool
+}
+
+// scanner returns the scan settings of the list.
+func (ll *LinkedList) scanner() *scanner {
+	if ll == nil || (ll.logger == nil && ll.converters == nil && ll.assumeLoc == nil && ll.convertLoc == nil && !ll.lenientBools) {
+		return nil
+	}
+	return &scanner{
+		logger:       ll.logger,
+		converters:   ll.converters,
+		assumeLoc:    ll.assumeLoc,
+		convertLoc:   ll.convertLoc,
+		lenientBools: ll.lenientBools,
+	}
+}
+
+// debug logs at debug level if the scanner has a logger.
+func (s *scanner) debug(msg string, args ...interface{}) {
+	if s != nil && s.logger != nil {
+		s.logger.Debug(msg, args...)
+	}
+}
+
+// converter returns the converter for t, if any.
+func (s *scanner) converter(t reflect.Type) ConverterFunc {
+	if s != nil {
+		if fn, ok := s.converters[t]; ok {
+			return fn
+		}
+	}
+	globalConverters.RLock()
+	defer globalConverters.RUnlock()
+	return globalConverters.m[t]
+}
+
+// convert sets field with a registered converter for fieldType or, for
+// pointer fields, its element type. It reports false if none is registered.
+func (s *scanner) convert(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (bool, error) {
+	if fn := s.converter(fieldType); fn != nil {
+		return true, assignConverted(field, fieldType, fn, dataValue)
+	}
+	if fieldType.Kind() == reflect.Ptr {
+		if fn := s.converter(fieldType.Elem()); fn != nil {
+			return true, assignConverted(field, fieldType, fn, dataValue)
+		}
+	}
+	return false, nil
+}
+
+// setStructField sets field from dataValue, applying the named converter of
+// a convert= option in the field's db tag if there is one.
+func (s *scanner) setStructField(field reflect.Value, sf reflect.StructField, dataValue interface{}) error {
+	name := fieldConverterName(sf)
+	if name == "" {
+		return s.setFieldValue(field, sf.Type, dataValue)
+	}
+	namedConverters.RLock()
+	fn := namedConverters.m[name]
+	namedConverters.RUnlock()
+	if fn == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownConverter, name)
+	}
+	return assignConverted(field, sf.Type, fn, dataValue)
+}
+
+// fieldConverterName returns the convert= option of the field's db tag.
+func fieldConverterName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("db")
+	if _, opts, ok := strings.Cut(tag, ","); ok {
+		for _, opt := range strings.Split(opts, ",") {
+			if name, ok := strings.CutPrefix(strings.TrimSpace(opt), "convert="); ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// assignConverted runs fn and stores its result in field. A result of the
+// element type of a pointer field is stored through a new pointer.
+func assignConverted(field reflect.Value, fieldType reflect.Type, fn ConverterFunc, dataValue interface{}) error {
+	v, err := fn(dataValue)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(fieldType):
+		field.Set(rv)
+	case rv.Type().ConvertibleTo(fieldType):
+		field.Set(rv.Convert(fieldType))
+	case fieldType.Kind() == reflect.Ptr && rv.Type().ConvertibleTo(fieldType.Elem()):
+		elem := reflect.New(fieldType.Elem())
+		elem.Elem().Set(rv.Convert(fieldType.Elem()))
+		field.Set(elem)
+	default:
+		return fmt.Errorf("converter for %v returned %T", fieldType, v)
+	}
+	return nil
+}
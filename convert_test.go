@@ -0,0 +1,155 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testCents int64
+
+type testPhone struct {
+	Digits string
+}
+
+func parseCents(v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %T", v)
+	}
+	var whole, frac int64
+	if _, err := fmt.Sscanf(s, "$%d.%d", &whole, &frac); err != nil {
+		return nil, err
+	}
+	return whole*100 + frac, nil
+}
+
+func TestRegisterConverter(t *testing.T) {
+	RegisterConverter(reflect.TypeOf(testCents(0)), parseCents)
+	defer RegisterConverter(reflect.TypeOf(testCents(0)), nil)
+
+	type row struct {
+		Price testCents  `db:"price"`
+		Spare *testCents `db:"spare"`
+	}
+	ll := New()
+	ll.Append(map[string]interface{}{"price": "$12.34", "spare": "$0.50"})
+
+	var rows []row
+	if err := ll.ToSlice(&rows); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	if rows[0].Price != 1234 {
+		t.Errorf("Expected price 1234, got %d", rows[0].Price)
+	}
+	if rows[0].Spare == nil || *rows[0].Spare != 50 {
+		t.Errorf("Expected spare 50, got %v", rows[0].Spare)
+	}
+}
+
+func TestWithConverterOverridesGlobal(t *testing.T) {
+	phoneType := reflect.TypeOf(testPhone{})
+	RegisterConverter(phoneType, func(v interface{}) (interface{}, error) {
+		return testPhone{Digits: "global"}, nil
+	})
+	defer RegisterConverter(phoneType, nil)
+
+	ll := New(WithConverter(phoneType, func(v interface{}) (interface{}, error) {
+		return testPhone{Digits: strings.NewReplacer("-", "", " ", "").Replace(v.(string))}, nil
+	}))
+	ll.Append(map[string]interface{}{"phone": "555-12 34"})
+
+	var dest struct {
+		Phone testPhone `db:"phone"`
+	}
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if dest.Phone.Digits != "5551234" {
+		t.Errorf("Expected digits 5551234, got %q", dest.Phone.Digits)
+	}
+
+	// StructScan has no list, so only the global converter applies.
+	if err := ll.First().StructScan(&dest); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if dest.Phone.Digits != "global" {
+		t.Errorf("Expected digits global, got %q", dest.Phone.Digits)
+	}
+}
+
+func TestConverterError(t *testing.T) {
+	errBad := errors.New("bad value")
+	ll := New(WithConverter(reflect.TypeOf(testCents(0)), func(v interface{}) (interface{}, error) {
+		return nil, errBad
+	}))
+	ll.Append(map[string]interface{}{"price": "oops"})
+
+	var dest struct {
+		Price testCents `db:"price"`
+	}
+	if err := ll.ScanFirst(&dest); !errors.Is(err, errBad) {
+		t.Errorf("Expected errBad, got %v", err)
+	}
+}
+
+func TestConverterResultTypeMismatch(t *testing.T) {
+	ll := New(WithConverter(reflect.TypeOf(testCents(0)), func(v interface{}) (interface{}, error) {
+		return "not a number", nil
+	}))
+	ll.Append(map[string]interface{}{"price": 1})
+
+	var dest struct {
+		Price testCents `db:"price"`
+	}
+	if err := ll.ScanFirst(&dest); err == nil {
+		t.Error("Expected error for unconvertible converter result, got nil")
+	}
+}
+
+func TestNamedConverterTag(t *testing.T) {
+	RegisterNamedConverter("testCentsToFloat", func(v interface{}) (interface{}, error) {
+		c, ok := toInt64(v)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T", v)
+		}
+		return float64(c) / 100, nil
+	})
+	defer RegisterNamedConverter("testCentsToFloat", nil)
+
+	type row struct {
+		Price float64  `db:"price,convert=testCentsToFloat"`
+		Cost  *float64 `db:"cost,convert=testCentsToFloat"`
+		Qty   int      `db:"qty"`
+	}
+	ll := New()
+	ll.Append(map[string]interface{}{"price": int64(1999), "cost": 250, "qty": 3})
+
+	var rows []row
+	if err := ll.ToSlice(&rows); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	if rows[0].Price != 19.99 {
+		t.Errorf("Expected price 19.99, got %v", rows[0].Price)
+	}
+	if rows[0].Cost == nil || *rows[0].Cost != 2.5 {
+		t.Errorf("Expected cost 2.5, got %v", rows[0].Cost)
+	}
+	if rows[0].Qty != 3 {
+		t.Errorf("Expected qty 3, got %d", rows[0].Qty)
+	}
+}
+
+func TestNamedConverterUnknown(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"price": 1})
+
+	var dest struct {
+		Price float64 `db:"price,convert=noSuchConverter"`
+	}
+	if err := ll.ScanFirst(&dest); !errors.Is(err, ErrUnknownConverter) {
+		t.Errorf("Expected ErrUnknownConverter, got %v", err)
+	}
+}
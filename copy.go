@@ -0,0 +1,124 @@
+package linkedlist
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CopyConn runs a PostgreSQL COPY ... FROM STDIN statement reading its data
+// from r and returns the number of rows copied. With pgx it is a thin
+// wrapper around (*pgconn.PgConn).CopyFrom:
+//
+//	func (c pgCopy) CopyFrom(ctx context.Context, r io.Reader, sql string) (int64, error) {
+//		tag, err := c.conn.PgConn().CopyFrom(ctx, r, sql)
+//		return tag.RowsAffected(), err
+//	}
+type CopyConn interface {
+	CopyFrom(ctx context.Context, r io.Reader, sql string) (int64, error)
+}
+
+// CopyTo bulk-loads the list into table with COPY in text format and returns
+// the number of rows copied. The columns are those of all rows, in name order;
+// rows missing a column send NULL. An empty list copies nothing and runs
+// no statement.
+func (ll *LinkedList) CopyTo(ctx context.Context, conn CopyConn, table string) (int64, error) {
+	if ll.Len() == 0 {
+		return 0, nil
+	}
+	columns := ll.columnNames()
+	if len(columns) == 0 {
+		return 0, errors.New("rows have no columns to copy")
+	}
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = Postgres.quoteName(c)
+	}
+	sql := fmt.Sprintf("COPY %s (%s) FROM STDIN", Postgres.QuoteIdent(table), strings.Join(quoted, ", "))
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(ll.WriteCopy(pw, columns))
+	}()
+	n, err := conn.CopyFrom(ctx, pr, sql)
+	pr.Close()
+	if err != nil {
+		return n, fmt.Errorf("COPY into %s failed: %w", table, err)
+	}
+	ll.record("export", int(n), "CopyTo %s", table)
+	return n, nil
+}
+
+// WriteCopy writes the given columns of every row to w in PostgreSQL COPY
+// text format: tab-separated, one row per line, NULL as \N.
+func (ll *LinkedList) WriteCopy(w io.Writer, columns []string) error {
+	bw := bufio.NewWriter(w)
+	err := ll.each(func(n *Node) error {
+		for i, c := range columns {
+			if i > 0 {
+				bw.WriteByte('\t')
+			}
+			bw.WriteString(copyText(n.Data[c]))
+		}
+		return bw.WriteByte('\n')
+	})
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// copyText formats a value as a COPY text field.
+func copyText(v interface{}) string {
+	var s string
+	switch x := v.(type) {
+	case nil:
+		return `\N`
+	case bool:
+		if x {
+			return "t"
+		}
+		return "f"
+	case []byte:
+		s = `\x` + hex.EncodeToString(x)
+	case time.Time:
+		s = x.Format("2006-01-02 15:04:05.999999999Z07:00")
+	case float64:
+		s = strconv.FormatFloat(x, 'g', -1, 64)
+	case float32:
+		s = strconv.FormatFloat(float64(x), 'g', -1, 32)
+	default:
+		var ok bool
+		if s, ok = bigText(x); !ok {
+			s = fmt.Sprint(x)
+		}
+	}
+	return copyEscaper.Replace(s)
+}
+
+// copyEscaper escapes the characters with special meaning in COPY text.
+var copyEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// columnNames returns the names of all columns of all rows, sorted.
+func (ll *LinkedList) columnNames() []string {
+	seen := make(map[string]bool)
+	ll.each(func(n *Node) error {
+		for k := range n.Data {
+			seen[k] = true
+		}
+		return nil
+	})
+	names := make([]string, 0, len(seen))
+	for k := range seen {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,85 @@
+package linkedlist
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+type recordingCopy struct {
+	sql  string
+	data string
+	err  error
+}
+
+func (c *recordingCopy) CopyFrom(ctx context.Context, r io.Reader, sql string) (int64, error) {
+	c.sql = sql
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	c.data = string(raw)
+	if c.err != nil {
+		return 0, c.err
+	}
+	return int64(bytes.Count(raw, []byte("\n"))), nil
+}
+
+func TestWriteCopy_Escaping(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"name": "tab\there\nand \\ slash",
+		"ok":   true,
+		"raw":  []byte{0xde, 0xad},
+		"at":   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		"n":    nil,
+	})
+	var buf bytes.Buffer
+	if err := ll.WriteCopy(&buf, []string{"name", "ok", "raw", "at", "n", "missing"}); err != nil {
+		t.Fatalf("WriteCopy failed: %v", err)
+	}
+	want := "tab\\there\\nand \\\\ slash\tt\t\\\\xdead\t2024-01-02 03:04:05Z\t\\N\t\\N\n"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestCopyTo(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	ll.Append(map[string]interface{}{"id": 2})
+
+	conn := &recordingCopy{}
+	n, err := ll.CopyTo(context.Background(), conn, "users")
+	if err != nil {
+		t.Fatalf("CopyTo failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 rows copied, got %d", n)
+	}
+	if conn.sql != `COPY "users" ("id", "name") FROM STDIN` {
+		t.Errorf("Unexpected statement: %s", conn.sql)
+	}
+	if conn.data != "1\tAlice\n2\t\\N\n" {
+		t.Errorf("Unexpected data: %q", conn.data)
+	}
+
+	boom := errors.New("boom")
+	if _, err := ll.CopyTo(context.Background(), &recordingCopy{err: boom}, "users"); !errors.Is(err, boom) {
+		t.Errorf("Expected copy error, got %v", err)
+	}
+}
+
+func TestCopyTo_Empty(t *testing.T) {
+	conn := &recordingCopy{}
+	n, err := New().CopyTo(context.Background(), conn, "users")
+	if err != nil || n != 0 {
+		t.Errorf("Expected 0 rows and no error, got %d, %v", n, err)
+	}
+	if conn.sql != "" {
+		t.Errorf("Expected no statement, got %s", conn.sql)
+	}
+}
@@ -0,0 +1,143 @@
+package linkedlist
+
+import (
+	"errors"
+	"maps"
+)
+
+// ErrFrozen is returned, or raised as a panic by methods without an error
+// result, when a frozen list is mutated.
+var ErrFrozen = errors.New("linkedlist: list is frozen")
+
+// Freeze returns an immutable view of the list's rows, spilled ones
+// included. The view holds its own copies of the nodes sharing their Data,
+// so neither later changes to ll nor Set on ll's nodes are visible through
+// it. It keeps ll's converters, time zones, lenient bools and logger, so
+// scanning through the view gives the same results as scanning ll. Calling
+// Set on a node of the view panics with ErrFrozen. Read errors are
+// reported by ll's SpillErr.
+func (ll *LinkedList) Freeze() *LinkedList {
+	if ll == nil {
+		return &LinkedList{frozen: true}
+	}
+	view := &LinkedList{
+		meta:         maps.Clone(ll.meta),
+		frozen:       true,
+		logger:       ll.logger,
+		columnOrder:  ll.columnOrder,
+		converters:   maps.Clone(ll.converters),
+		assumeLoc:    ll.assumeLoc,
+		convertLoc:   ll.convertLoc,
+		lenientBools: ll.lenientBools,
+	}
+	if ll.frozen {
+		// Frozen nodes never change, so a view of a view shares them. This
+		// writes nothing and is safe from concurrent readers.
+		view.head, view.tail, view.len = ll.head, ll.tail, ll.len
+		view.current = view.head
+		return view
+	}
+	// Spilled rows are read back as fresh frozen nodes.
+	ll.spill.forEach(func(n *Node) error {
+		view.appendFrozen(n)
+		return nil
+	})
+	for n := ll.head; n != nil; n = n.next {
+		c := n.shareCopy()
+		// Decode compacted rows into the copy without touching n, so
+		// readers of the view never write to its nodes.
+		if c.packed != nil {
+			if data, err := decodeRow(c.packed); err == nil {
+				c.Data, c.packed, c.sharedData = data, nil, false
+			}
+		}
+		c.frozen = true
+		view.appendFrozen(c)
+	}
+	view.current = view.head
+	return view
+}
+
+// appendFrozen links n at the end of a view being built by Freeze.
+func (ll *LinkedList) appendFrozen(n *Node) {
+	n.next = nil
+	if ll.head == nil {
+		ll.head = n
+	} else {
+		ll.tail.next = n
+	}
+	ll.tail = n
+	ll.len++
+}
+
+// IsFrozen reports whether the list is an immutable view created by Freeze.
+func (ll *LinkedList) IsFrozen() bool {
+	return ll != nil && ll.frozen
+}
+
+// beginMutation prepares the list for a change. It returns ErrFrozen for
+// frozen lists.
+func (ll *LinkedList) beginMutation() error {
+	if ll.frozen {
+		return ErrFrozen
+	}
+	return nil
+}
+
+// mustMutate is beginMutation for methods without an error result.
+func (ll *LinkedList) mustMutate() {
+	if err := ll.beginMutation(); err != nil {
+		panic(err)
+	}
+}
+
+// shareCopy returns an unlinked copy of n that shares its Data map. Both
+// nodes copy the map before their next change through the package API.
+func (n *Node) shareCopy() *Node {
+	// Nodes of frozen views are always shared; skipping the store keeps
+	// copying from them free of writes.
+	if !n.sharedData {
+		n.sharedData = true
+	}
+	c := n.derive(n.Data)
+	c.packed = n.packed
+	c.sharedData = true
+	c.deleted = n.deleted
+	return c
+}
+
+// derive returns an unlinked node holding data and n's provenance: its
+// timestamp, metadata, row number and source.
+func (n *Node) derive(data map[string]interface{}) *Node {
+	return &Node{
+		Data:      data,
+		timestamp: n.timestamp,
+		meta:      maps.Clone(n.meta),
+		rowNum:    n.rowNum,
+		source:    n.source,
+	}
+}
+
+// own gives the node a private copy of its Data if it is shared.
+func (n *Node) own() {
+	n.expand()
+	if n.sharedData {
+		n.Data = maps.Clone(n.Data)
+		n.sharedData = false
+	}
+}
+
+// Set stores value under key in the node's Data, first copying Data if it
+// is shared with another list. Writing to Data directly bypasses this
+// copy-on-write protection. Set panics with ErrFrozen on nodes of a frozen
+// view.
+func (n *Node) Set(key string, value interface{}) {
+	if n.frozen {
+		panic(ErrFrozen)
+	}
+	n.own()
+	if n.Data == nil {
+		n.Data = make(map[string]interface{})
+	}
+	n.Data[key] = value
+}
@@ -0,0 +1,152 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFreeze_IsolatedFromOriginal(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 3})
+	ll.Append(map[string]interface{}{"ID": 1})
+
+	view := ll.Freeze()
+	if !view.IsFrozen() || ll.IsFrozen() {
+		t.Fatal("Expected only the view to be frozen")
+	}
+	if reflect.ValueOf(view.head.Data).Pointer() != reflect.ValueOf(ll.head.Data).Pointer() {
+		t.Error("Expected view to share Data until a write")
+	}
+
+	ll.Append(map[string]interface{}{"ID": 2})
+	ll.Sort(lessByID)
+	if err := ll.CoerceColumn("ID", KindCoercer(reflect.Int64)); err != nil {
+		t.Fatalf("CoerceColumn failed: %v", err)
+	}
+
+	if view.Len() != 2 || view.First().Data["ID"] != 3 || view.Last().Data["ID"] != 1 {
+		t.Errorf("View changed after mutating original: %v .. %v", view.First().Data, view.Last().Data)
+	}
+	if view.Last().next != nil {
+		t.Error("View tail must not be linked to new nodes")
+	}
+	if ll.Len() != 3 || ll.First().Data["ID"] != int64(1) {
+		t.Errorf("Unexpected original after mutation: %v", ll.First().Data)
+	}
+}
+
+func TestFreeze_RejectsMutation(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1})
+	view := ll.Freeze()
+
+	if err := view.CoerceColumn("ID", KindCoercer(reflect.String)); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Expected ErrFrozen from CoerceColumn, got %v", err)
+	}
+	if err := view.OrderBy("ID"); err != nil {
+		t.Errorf("Expected single-row OrderBy to be a no-op, got %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrFrozen {
+			t.Errorf("Expected Append to panic with ErrFrozen, got %v", r)
+		}
+	}()
+	view.Append(map[string]interface{}{"ID": 2})
+}
+
+func TestFreeze_SetIsolated(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1})
+	view := ll.Freeze()
+
+	ll.First().Set("ID", 2)
+	if view.First().Data["ID"] != 1 {
+		t.Errorf("View changed through original Set: %v", view.First().Data)
+	}
+	if ll.First().Data["ID"] != 2 {
+		t.Errorf("Expected original change, got %v", ll.First().Data)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrFrozen {
+			t.Errorf("Expected Set on view node to panic with ErrFrozen, got %v", r)
+		}
+		if ll.First().Data["ID"] != 2 {
+			t.Errorf("Original changed through view Set: %v", ll.First().Data)
+		}
+	}()
+	view.First().Set("ID", 3)
+}
+
+func TestFilter_CopyOnWrite(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1, "name": "a"})
+
+	derived := ll.Filter(func(*Node) bool { return true })
+	if reflect.ValueOf(derived.First().Data).Pointer() != reflect.ValueOf(ll.First().Data).Pointer() {
+		t.Fatal("Expected derived list to share Data")
+	}
+
+	derived.First().Set("name", "b")
+	if ll.First().Data["name"] != "a" {
+		t.Errorf("Original changed through derived list: %v", ll.First().Data)
+	}
+	if derived.First().Data["name"] != "b" {
+		t.Errorf("Expected derived change, got %v", derived.First().Data)
+	}
+
+	ll.First().Set("ID", 9)
+	if derived.First().Data["ID"] != 1 {
+		t.Errorf("Derived changed through original list: %v", derived.First().Data)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	ll := peopleList()
+	got := ll.Select("name", "missing")
+	if got.Len() != 4 {
+		t.Fatalf("Expected 4 rows, got %d", got.Len())
+	}
+	first := got.First().Data
+	if len(first) != 1 || first["name"] != "Alice" {
+		t.Errorf("Unexpected projection: %+v", first)
+	}
+}
+
+func TestFreeze_IncludesSpilledRows(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+	for id := 1; id <= 5; id++ {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+
+	view := ll.Freeze()
+	var ids []interface{}
+	for _, n := range view.Nodes() {
+		ids = append(ids, n.Data["ID"])
+	}
+	if view.Len() != 5 || !reflect.DeepEqual(ids, []interface{}{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected all 5 rows in the view, got %d: %v", view.Len(), ids)
+	}
+}
+
+func TestFreeze_KeepsScanSettings(t *testing.T) {
+	ll := New(
+		WithConverter(reflect.TypeOf(testCents(0)), parseCents),
+		WithLenientBools(),
+	)
+	ll.Append(map[string]interface{}{"price": "$1.25", "paid": "t"})
+
+	var dest struct {
+		Price testCents `db:"price"`
+		Paid  bool      `db:"paid"`
+	}
+	if err := ll.Freeze().ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst on the view failed: %v", err)
+	}
+	if dest.Price != 125 || !dest.Paid {
+		t.Errorf("Expected price 125 and paid, got %+v", dest)
+	}
+}
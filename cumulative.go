@@ -0,0 +1,82 @@
+package linkedlist
+
+import "fmt"
+
+// CumOp selects the running aggregate computed by Cumulative.
+type CumOp int
+
+const (
+	CumSum   CumOp = iota // running sum, in column "cumsum_<column>"
+	CumCount              // running count of non-NULL values, "cumcount_<column>"
+	CumMin                // running minimum, "cummin_<column>"
+	CumMax                // running maximum, "cummax_<column>"
+)
+
+// cumOps maps each CumOp to its aggregate and column prefix.
+var cumOps = map[CumOp]struct {
+	fn     AggFunc
+	prefix string
+}{
+	CumSum:   {AggSum, "cumsum_"},
+	CumCount: {AggCount, "cumcount_"},
+	CumMin:   {AggMin, "cummin_"},
+	CumMax:   {AggMax, "cummax_"},
+}
+
+// Cumulative adds a column holding the running aggregate of column over
+// the rows so far in list order, restarting for each combination of the
+// partitionBy columns like SUM(column) OVER (PARTITION BY ... ROWS
+// UNBOUNDED PRECEDING). NULL values are skipped; rows before the first
+// non-NULL value get NULL, except for CumCount, which starts at 0. Sort the
+// list first for a different window order. On error the list is unchanged.
+func (ll *LinkedList) Cumulative(column string, op CumOp, partitionBy ...string) error {
+	spec, ok := cumOps[op]
+	if !ok {
+		return fmt.Errorf("unknown cumulative operation %d", op)
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	states := make(map[string]*aggState)
+	var nodes []*Node
+	var values []interface{}
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		keys := make([]interface{}, len(partitionBy))
+		for i, c := range partitionBy {
+			keys[i], _ = lookupColumn(n.Data, c)
+		}
+		k := encodeKey(keys)
+		s := states[k]
+		if s == nil {
+			s = &aggState{}
+			states[k] = s
+		}
+		v, _ := lookupColumn(n.Data, column)
+		if err := s.add(spec.fn, v); err != nil {
+			return fmt.Errorf("row %d: %w", pos, err)
+		}
+		var running interface{}
+		if spec.fn == AggCount {
+			running = s.count
+		} else {
+			running = s.result(spec.fn)
+		}
+		nodes = append(nodes, n)
+		values = append(values, running)
+	}
+
+	for i, n := range nodes {
+		n.Set(spec.prefix+column, values[i])
+	}
+	ll.record("update", len(nodes), "Cumulative %s%s", spec.prefix, column)
+	return nil
+}
@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func cumulativeList() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"acct": "a", "amount": 10})
+	ll.Append(map[string]interface{}{"acct": "b", "amount": 5})
+	ll.Append(map[string]interface{}{"acct": "a", "amount": nil})
+	ll.Append(map[string]interface{}{"acct": "a", "amount": 7})
+	ll.Append(map[string]interface{}{"acct": "b", "amount": 2.5})
+	return ll
+}
+
+func TestCumulative(t *testing.T) {
+	cases := []struct {
+		op        CumOp
+		partition []string
+		column    string
+		want      []interface{}
+	}{
+		{CumSum, nil, "cumsum_amount", []interface{}{int64(10), int64(15), int64(15), int64(22), 24.5}},
+		{CumSum, []string{"acct"}, "cumsum_amount", []interface{}{int64(10), int64(5), int64(10), int64(17), 7.5}},
+		{CumCount, []string{"acct"}, "cumcount_amount", []interface{}{int64(1), int64(1), int64(1), int64(2), int64(2)}},
+		{CumMax, []string{"acct"}, "cummax_amount", []interface{}{10, 5, 10, 10, 5}},
+		{CumMin, nil, "cummin_amount", []interface{}{10, 5, 5, 5, 2.5}},
+	}
+	for _, c := range cases {
+		ll := cumulativeList()
+		if err := ll.Cumulative("amount", c.op, c.partition...); err != nil {
+			t.Fatalf("Cumulative(%d) failed: %v", c.op, err)
+		}
+		if got := columnValues(ll, c.column); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Expected %s %v, got %v", c.column, c.want, got)
+		}
+	}
+}
+
+func TestCumulativeLeadingNull(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"x": nil})
+	ll.Append(map[string]interface{}{"x": 1})
+	if err := ll.Cumulative("x", CumSum); err != nil {
+		t.Fatalf("Cumulative failed: %v", err)
+	}
+	if got := columnValues(ll, "cumsum_x"); !reflect.DeepEqual(got, []interface{}{nil, int64(1)}) {
+		t.Errorf("Expected [nil 1], got %v", got)
+	}
+}
+
+func TestCumulativeErrorLeavesListUnchanged(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"x": 1})
+	ll.Append(map[string]interface{}{"x": "two"})
+	if err := ll.Cumulative("x", CumSum); err == nil {
+		t.Fatal("Expected error summing a string, got nil")
+	}
+	if _, ok := ll.First().Data["cumsum_x"]; ok {
+		t.Error("Expected no column added on error")
+	}
+	if err := ll.Cumulative("x", CumOp(9)); err == nil {
+		t.Error("Expected error for unknown operation, got nil")
+	}
+}
@@ -0,0 +1,95 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// cursorToken is the decoded form of a continuation token.
+type cursorToken struct {
+	Column string      `json:"c"`
+	Value  interface{} `json:"v"`
+}
+
+// CursorAfter returns the next page of keyset pagination: up to limit rows
+// whose keyColumn is greater than lastValue, ordered by keyColumn, and an
+// opaque token for the page after it. Pass a nil lastValue for the first
+// page. The token is empty on the last page; otherwise ParseCursor turns it
+// back into the arguments for the next call. Keys should be unique; rows with
+// a NULL key are never returned. The page shares Data with ll.
+func (ll *LinkedList) CursorAfter(keyColumn string, lastValue interface{}, limit int) (*LinkedList, string, error) {
+	if limit <= 0 {
+		return nil, "", errors.New("limit must be positive")
+	}
+
+	var rows []*Node
+	err := ll.each(func(n *Node) error {
+		v := n.Data[keyColumn]
+		if v == nil {
+			return nil
+		}
+		if lastValue != nil {
+			c, err := compareValues(v, lastValue)
+			if err != nil {
+				return fmt.Errorf("error comparing %s: %w", keyColumn, err)
+			}
+			if c <= 0 {
+				return nil
+			}
+		}
+		rows = append(rows, n)
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var sortErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		c, err := compareValues(rows[i].Data[keyColumn], rows[j].Data[keyColumn])
+		if err != nil && sortErr == nil {
+			sortErr = fmt.Errorf("error comparing %s: %w", keyColumn, err)
+		}
+		return c < 0
+	})
+	if sortErr != nil {
+		return nil, "", sortErr
+	}
+
+	page := New()
+	for i := 0; i < len(rows) && i < limit; i++ {
+		page.appendNode(rows[i].shareCopy())
+	}
+	if len(rows) <= limit {
+		return page, "", nil
+	}
+	raw, err := json.Marshal(cursorToken{Column: keyColumn, Value: page.Last().Data[keyColumn]})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return page, base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// ParseCursor decodes a token returned by CursorAfter into the key column
+// and last value to request the next page with.
+func ParseCursor(token string) (keyColumn string, lastValue interface{}, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var tok cursorToken
+	if err := dec.Decode(&tok); err != nil || tok.Column == "" {
+		return "", nil, errors.New("invalid cursor")
+	}
+	value, err := DecodeJSONNumbers(tok.Value)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return tok.Column, value, nil
+}
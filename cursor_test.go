@@ -0,0 +1,72 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCursorAfter_Pages(t *testing.T) {
+	ll := New()
+	for _, id := range []int64{5, 1, 4, 2, 3} {
+		ll.Append(map[string]interface{}{"id": id})
+	}
+	ll.Append(map[string]interface{}{"id": nil})
+
+	var got []int64
+	var last interface{}
+	pages := 0
+	for {
+		page, token, err := ll.CursorAfter("id", last, 2)
+		if err != nil {
+			t.Fatalf("CursorAfter failed: %v", err)
+		}
+		pages++
+		for n := page.First(); n != nil; n = n.next {
+			got = append(got, n.Data["id"].(int64))
+		}
+		if token == "" {
+			break
+		}
+		column, value, err := ParseCursor(token)
+		if err != nil || column != "id" {
+			t.Fatalf("ParseCursor failed: %q, %v", column, err)
+		}
+		last = value
+	}
+	if pages != 3 || !reflect.DeepEqual(got, []int64{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected ids 1..5 over 3 pages, got %v over %d", got, pages)
+	}
+}
+
+func TestCursorAfter_TimeKeys(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ll := New()
+	for i := 0; i < 3; i++ {
+		ll.Append(map[string]interface{}{"at": base.Add(time.Duration(i) * time.Hour)})
+	}
+	_, token, err := ll.CursorAfter("at", nil, 1)
+	if err != nil || token == "" {
+		t.Fatalf("Expected a token, got %q, %v", token, err)
+	}
+	_, last, err := ParseCursor(token)
+	if err != nil {
+		t.Fatalf("ParseCursor failed: %v", err)
+	}
+	page, _, err := ll.CursorAfter("at", last, 1)
+	if err != nil {
+		t.Fatalf("CursorAfter failed: %v", err)
+	}
+	if !page.First().Data["at"].(time.Time).Equal(base.Add(time.Hour)) {
+		t.Errorf("Expected second hour, got %v", page.First().Data["at"])
+	}
+}
+
+func TestCursorAfter_Errors(t *testing.T) {
+	if _, _, err := New().CursorAfter("id", nil, 0); err == nil {
+		t.Error("Expected error for zero limit")
+	}
+	if _, _, err := ParseCursor("not a token"); err == nil {
+		t.Error("Expected error for invalid token")
+	}
+}
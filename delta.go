@@ -0,0 +1,85 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Diff writes into each row a newName column holding column minus its
+// value in the previous row with the same partitionBy values. Integers give
+// an int64 difference, other numbers a float64. The first row of each
+// partition, and rows where either value is NULL, get nil. Non-numeric
+// values are an error, leaving the list unchanged.
+func (ll *LinkedList) Diff(column, newName string, partitionBy ...string) error {
+	return ll.delta("Diff", column, newName, partitionBy, func(cur, prev interface{}) interface{} {
+		if c, ok := toInt64(cur); ok {
+			if p, ok := toInt64(prev); ok {
+				return c - p
+			}
+		}
+		c, _ := toFloat64(cur)
+		p, _ := toFloat64(prev)
+		return c - p
+	})
+}
+
+// PercentChange is Diff giving the change relative to the previous value
+// as a float64 fraction, so 0.25 means a 25% rise. A previous value of 0
+// gives nil.
+func (ll *LinkedList) PercentChange(column, newName string, partitionBy ...string) error {
+	return ll.delta("PercentChange", column, newName, partitionBy, func(cur, prev interface{}) interface{} {
+		c, _ := toFloat64(cur)
+		p, _ := toFloat64(prev)
+		if p == 0 {
+			return nil
+		}
+		return (c - p) / p
+	})
+}
+
+// delta writes fn(current, previous) for consecutive numeric values within
+// each partition.
+func (ll *LinkedList) delta(op, column, newName string, partitionBy []string, fn func(cur, prev interface{}) interface{}) error {
+	if newName == "" {
+		return errors.New("new column name must not be empty")
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	prev := make(map[string]interface{})
+	var nodes []*Node
+	var results []interface{}
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		keys := make([]interface{}, len(partitionBy))
+		for i, c := range partitionBy {
+			keys[i], _ = lookupColumn(n.Data, c)
+		}
+		k := encodeKey(keys)
+		cur, _ := lookupColumn(n.Data, column)
+		if _, ok := toFloat64(cur); cur != nil && !ok {
+			return fmt.Errorf("row %d: %s value %v is not numeric", pos, column, cur)
+		}
+		var r interface{}
+		if p := prev[k]; p != nil && cur != nil {
+			r = fn(cur, p)
+		}
+		prev[k] = cur
+		nodes = append(nodes, n)
+		results = append(results, r)
+	}
+
+	for i, n := range nodes {
+		n.Set(newName, results[i])
+	}
+	ll.record("update", len(nodes), "%s %s as %s", op, column, newName)
+	return nil
+}
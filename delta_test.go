@@ -0,0 +1,70 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func counterList() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"host": "a", "requests": 100})
+	ll.Append(map[string]interface{}{"host": "b", "requests": 10})
+	ll.Append(map[string]interface{}{"host": "a", "requests": 125})
+	ll.Append(map[string]interface{}{"host": "b", "requests": nil})
+	ll.Append(map[string]interface{}{"host": "b", "requests": 12.5})
+	ll.Append(map[string]interface{}{"host": "a", "requests": 100})
+	return ll
+}
+
+func TestDiff(t *testing.T) {
+	ll := counterList()
+	if err := ll.Diff("requests", "delta", "host"); err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	want := []interface{}{nil, nil, int64(25), nil, nil, int64(-25)}
+	if got := columnValues(ll, "delta"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	all := counterList()
+	if err := all.Diff("requests", "delta"); err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	want = []interface{}{nil, int64(-90), int64(115), nil, nil, 87.5}
+	if got := columnValues(all, "delta"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v without partitions, got %v", want, got)
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	ll := counterList()
+	if err := ll.PercentChange("requests", "pct", "host"); err != nil {
+		t.Fatalf("PercentChange failed: %v", err)
+	}
+	want := []interface{}{nil, nil, 0.25, nil, nil, -0.2}
+	if got := columnValues(ll, "pct"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	zero := New()
+	zero.Append(map[string]interface{}{"v": 0})
+	zero.Append(map[string]interface{}{"v": 5})
+	if err := zero.PercentChange("v", "pct"); err != nil {
+		t.Fatalf("PercentChange failed: %v", err)
+	}
+	if zero.Last().Data["pct"] != nil {
+		t.Errorf("Expected nil change from zero, got %v", zero.Last().Data["pct"])
+	}
+}
+
+func TestDiffNonNumeric(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"v": 1})
+	ll.Append(map[string]interface{}{"v": "x"})
+	if err := ll.Diff("v", "d"); err == nil {
+		t.Error("Expected error for non-numeric value, got nil")
+	}
+	if _, ok := ll.First().Data["d"]; ok {
+		t.Error("Expected list unchanged on error")
+	}
+}
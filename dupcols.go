@@ -0,0 +1,109 @@
+package linkedlist
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DuplicatePolicy selects what a load does when the result has several
+// columns with the same name, as SELECT * over a JOIN often does.
+type DuplicatePolicy int
+
+const (
+	// DuplicateLastWins, the default, loads every column under its name, so
+	// the last of several with the same name is the one kept.
+	DuplicateLastWins DuplicatePolicy = iota
+	// DuplicateError fails the load.
+	DuplicateError
+	// DuplicateSuffix keeps the first column's name and numbers the others
+	// name_1, name_2 and so on, skipping names already in the result.
+	DuplicateSuffix
+	// DuplicateTablePrefix names every duplicated column table.name, with
+	// the tables taken from LoadOptions.ColumnTables.
+	DuplicateTablePrefix
+)
+
+// ErrDuplicateColumn is returned by loads with DuplicateError when a column
+// name repeats.
+var ErrDuplicateColumn = errors.New("linkedlist: duplicate column")
+
+// resolveColumns returns the map keys to load the result columns cols as.
+func resolveColumns(cols []string, opts LoadOptions) ([]string, error) {
+	if opts.KeyCase != AsIs {
+		cased := make([]string, len(cols))
+		for i, c := range cols {
+			cased[i] = opts.KeyCase.apply(c)
+		}
+		cols = cased
+	}
+	count := make(map[string]int, len(cols))
+	for _, c := range cols {
+		count[c]++
+	}
+	if len(count) == len(cols) || opts.DuplicateColumns == DuplicateLastWins {
+		return cols, nil
+	}
+
+	names := make([]string, len(cols))
+	switch opts.DuplicateColumns {
+	case DuplicateError:
+		for _, c := range cols {
+			if count[c] > 1 {
+				return nil, fmt.Errorf("%w %q", ErrDuplicateColumn, c)
+			}
+		}
+	case DuplicateSuffix:
+		used := make(map[string]bool, len(cols))
+		for _, c := range cols {
+			used[c] = true
+		}
+		seen := make(map[string]int, len(cols))
+		for i, c := range cols {
+			seen[c]++
+			if seen[c] == 1 {
+				names[i] = c
+				continue
+			}
+			for {
+				name := c + "_" + strconv.Itoa(seen[c]-1)
+				if !used[name] {
+					used[name] = true
+					names[i] = name
+					break
+				}
+				seen[c]++
+			}
+		}
+	case DuplicateTablePrefix:
+		for i, c := range cols {
+			names[i] = c
+			if count[c] == 1 {
+				continue
+			}
+			if i >= len(opts.ColumnTables) || opts.ColumnTables[i] == "" {
+				return nil, fmt.Errorf("%w %q: no table given for column %d", ErrDuplicateColumn, c, i+1)
+			}
+			names[i] = opts.ColumnTables[i] + "." + c
+		}
+		seen := make(map[string]bool, len(names))
+		for _, n := range names {
+			if seen[n] {
+				return nil, fmt.Errorf("%w %q", ErrDuplicateColumn, n)
+			}
+			seen[n] = true
+		}
+	default:
+		return nil, fmt.Errorf("unknown duplicate column policy %d", opts.DuplicateColumns)
+	}
+	return names, nil
+}
+
+// loadedName returns the key result column i of type ct is loaded as.
+func loadedName(ct *sql.ColumnType, i int, loaded []string) string {
+	if i < len(loaded) {
+		return loaded[i]
+	}
+	return ct.Name()
+}
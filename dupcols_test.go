@@ -0,0 +1,66 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLoadDuplicateColumnsLastWins(t *testing.T) {
+	rows := queryRows(t, []string{"id", "name", "id", "name"}, []interface{}{int64(1), "order", int64(7), "customer"})
+	ll := New()
+	if err := ll.LoadFromSQLx(rows); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := map[string]interface{}{"id": int64(7), "name": "customer"}
+	if !reflect.DeepEqual(ll.head.Data, want) {
+		t.Errorf("Expected the last columns to win, got %v", ll.head.Data)
+	}
+}
+
+func TestLoadDuplicateColumnsError(t *testing.T) {
+	rows := queryRows(t, []string{"id", "name", "id", "name"}, []interface{}{int64(1), "order", int64(7), "customer"})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{DuplicateColumns: DuplicateError})
+	if !errors.Is(err, ErrDuplicateColumn) {
+		t.Fatalf("Expected ErrDuplicateColumn, got %v", err)
+	}
+	if ll.Len() != 0 {
+		t.Errorf("Expected no rows loaded, got %d", ll.Len())
+	}
+}
+
+func TestLoadDuplicateColumnsSuffix(t *testing.T) {
+	rows := queryRows(t, []string{"id", "name", "id", "id_1", "id"},
+		[]interface{}{int64(1), "a", int64(2), int64(3), int64(4)})
+	ll := New()
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{DuplicateColumns: DuplicateSuffix}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := map[string]interface{}{"id": int64(1), "name": "a", "id_2": int64(2), "id_1": int64(3), "id_3": int64(4)}
+	if !reflect.DeepEqual(ll.head.Data, want) {
+		t.Errorf("Expected %v, got %v", want, ll.head.Data)
+	}
+}
+
+func TestLoadDuplicateColumnsTablePrefix(t *testing.T) {
+	rows := queryRows(t, []string{"id", "name", "id", "total"}, []interface{}{int64(1), "a", int64(7), int64(9)})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{
+		DuplicateColumns: DuplicateTablePrefix,
+		ColumnTables:     []string{"orders", "orders", "customers", "orders"},
+	})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := map[string]interface{}{"orders.id": int64(1), "name": "a", "customers.id": int64(7), "total": int64(9)}
+	if !reflect.DeepEqual(ll.head.Data, want) {
+		t.Errorf("Expected %v, got %v", want, ll.head.Data)
+	}
+
+	rows = queryRows(t, []string{"id", "id"}, []interface{}{int64(1), int64(2)})
+	err = New().LoadFromSQLxWithOptions(rows, LoadOptions{DuplicateColumns: DuplicateTablePrefix})
+	if !errors.Is(err, ErrDuplicateColumn) {
+		t.Errorf("Expected ErrDuplicateColumn without tables, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package linkedlist
+
+import (
+	"math"
+	"reflect"
+)
+
+// FloatEpsilon is the tolerance DefaultRowEqual allows between float values.
+const FloatEpsilon = 1e-9
+
+// Equal reports whether a and b hold the same rows in the same order,
+// comparing rows with DefaultRowEqual.
+func Equal(a, b *LinkedList) bool {
+	return EqualFunc(a, b, DefaultRowEqual)
+}
+
+// EqualFunc reports whether a and b have the same length and cmp reports
+// every pair of rows at the same position as equal. Nil lists are empty.
+func EqualFunc(a, b *LinkedList, cmp func(x, y map[string]interface{}) bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	xs, ys := a.Nodes(), b.Nodes()
+	if len(xs) != len(ys) {
+		// A spill file could not be read in full.
+		return false
+	}
+	for i, x := range xs {
+		if !cmp(x.Data, ys[i].Data) {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultRowEqual compares two rows key by key. Numeric values are compared
+// by value regardless of their Go type, so int(1) equals int64(1), and floats
+// match within FloatEpsilon. Other values are compared with reflect.DeepEqual.
+func DefaultRowEqual(x, y map[string]interface{}) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for k, xv := range x {
+		yv, ok := y[k]
+		if !ok || !valuesEqual(xv, yv) {
+			return false
+		}
+	}
+	return true
+}
+
+// valuesEqual compares two values with numeric tolerance.
+func valuesEqual(x, y interface{}) bool {
+	if xi, ok := toInt64(x); ok {
+		if yi, ok := toInt64(y); ok {
+			return xi == yi
+		}
+	}
+	if xf, ok := toFloat64(x); ok {
+		if yf, ok := toFloat64(y); ok {
+			return math.Abs(xf-yf) <= FloatEpsilon
+		}
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// toInt64 converts any signed or unsigned integer value to int64.
+func toInt64(v interface{}) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(u), true
+	}
+	return 0, false
+}
+
+// toFloat64 converts any numeric value to float64.
+func toFloat64(v interface{}) (float64, bool) {
+	if i, ok := toInt64(v); ok {
+		return float64(i), true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	}
+	return 0, false
+}
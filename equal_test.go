@@ -0,0 +1,49 @@
+package linkedlist
+
+import "testing"
+
+func TestEqual_NumericTolerance(t *testing.T) {
+	a := New()
+	a.Append(map[string]interface{}{"id": int64(1), "price": 0.1 + 0.2, "name": "x"})
+	b := New()
+	b.Append(map[string]interface{}{"id": 1, "price": 0.3, "name": "x"})
+
+	if !Equal(a, b) {
+		t.Error("Expected lists to be equal with numeric tolerance")
+	}
+
+	b.First().Data["price"] = 0.31
+	if Equal(a, b) {
+		t.Error("Expected lists with different prices to differ")
+	}
+}
+
+func TestEqual_LengthAndKeys(t *testing.T) {
+	a := New()
+	a.Append(map[string]interface{}{"id": 1})
+	b := New()
+	if Equal(a, b) {
+		t.Error("Expected lists of different length to differ")
+	}
+	b.Append(map[string]interface{}{"ID": 1})
+	if Equal(a, b) {
+		t.Error("Expected rows with different keys to differ")
+	}
+	if !Equal(nil, New()) {
+		t.Error("Expected nil list to equal empty list")
+	}
+}
+
+func TestEqualFunc_Custom(t *testing.T) {
+	a := New()
+	a.Append(map[string]interface{}{"id": 1, "updated_at": "yesterday"})
+	b := New()
+	b.Append(map[string]interface{}{"id": 1, "updated_at": "today"})
+
+	byID := func(x, y map[string]interface{}) bool {
+		return valuesEqual(x["id"], y["id"])
+	}
+	if !EqualFunc(a, b, byID) {
+		t.Error("Expected custom comparison to ignore updated_at")
+	}
+}
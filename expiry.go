@@ -0,0 +1,64 @@
+package linkedlist
+
+import "time"
+
+// Clock supplies the current time for timestamping and expiring nodes.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock backed by time.Now.
+type SystemClock struct{}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// WithTimestamps stamps every appended node with the time reported by clock.
+func WithTimestamps(clock Clock) Option {
+	return func(ll *LinkedList) {
+		ll.clock = clock
+	}
+}
+
+// Timestamp returns the time the node was stamped, or the zero time if the
+// node carries no timestamp.
+func (n *Node) Timestamp() time.Time {
+	return n.timestamp
+}
+
+// AppendAt adds a new row to the end of the list stamped with t.
+func (ll *LinkedList) AppendAt(data map[string]interface{}, t time.Time) {
+	if ll == nil {
+		return
+	}
+	ll.appendNode(&Node{Data: data, timestamp: t})
+}
+
+// ExpireOlderThan removes every timestamped node older than d relative to
+// clock and returns the number of nodes removed. Nodes without a timestamp
+// never expire.
+func (ll *LinkedList) ExpireOlderThan(d time.Duration, clock Clock) int {
+	if ll == nil {
+		return 0
+	}
+	ll.mustMutate()
+	cutoff := clock.Now().Add(-d)
+	removed := 0
+
+	var prev *Node
+	for n := ll.head; n != nil; {
+		next := n.next
+		if !n.timestamp.IsZero() && n.timestamp.Before(cutoff) {
+			ll.unlink(prev, n)
+			ll.mods++
+			removed++
+		} else {
+			prev = n
+		}
+		n = next
+	}
+
+	return removed
+}
@@ -0,0 +1,52 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestWithTimestamps_StampsAppend(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ll := New(WithTimestamps(clock))
+	ll.Append(map[string]interface{}{"ID": 1})
+	if !ll.First().Timestamp().Equal(clock.now) {
+		t.Errorf("Expected timestamp %v, got %v", clock.now, ll.First().Timestamp())
+	}
+
+	plain := New()
+	plain.Append(map[string]interface{}{"ID": 1})
+	if !plain.First().Timestamp().IsZero() {
+		t.Errorf("Expected zero timestamp without clock, got %v", plain.First().Timestamp())
+	}
+}
+
+func TestExpireOlderThan(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: base}
+	ll := New()
+	ll.AppendAt(map[string]interface{}{"ID": 1}, base.Add(-10*time.Minute))
+	ll.Append(map[string]interface{}{"ID": 2}) // no timestamp, never expires
+	ll.AppendAt(map[string]interface{}{"ID": 3}, base.Add(-6*time.Minute))
+	ll.AppendAt(map[string]interface{}{"ID": 4}, base.Add(-time.Minute))
+
+	removed := ll.ExpireOlderThan(5*time.Minute, clock)
+	if removed != 2 {
+		t.Errorf("Expected 2 nodes removed, got %d", removed)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected Len() to be 2, got %d", ll.Len())
+	}
+	if ll.First().Data["ID"] != 2 || ll.Last().Data["ID"] != 4 {
+		t.Errorf("Unexpected remaining nodes: first %v, last %v", ll.First().Data, ll.Last().Data)
+	}
+
+	clock.now = base.Add(time.Hour)
+	ll.ExpireOlderThan(5*time.Minute, clock)
+	if ll.Len() != 1 || ll.Last().Data["ID"] != 2 {
+		t.Errorf("Expected only untimestamped node to remain, got len %d", ll.Len())
+	}
+}
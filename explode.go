@@ -0,0 +1,45 @@
+package linkedlist
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+)
+
+// Explode returns a new list with one row per element of column's slice
+// values, each a copy of its row with the element in place of the slice,
+// like SQL's UNNEST. Rows whose value is NULL or an empty slice produce no
+// rows; other non-slice values are an error.
+func (ll *LinkedList) Explode(column string) (*LinkedList, error) {
+	var err error
+	pos := 0
+	result := ll.FlatMap(func(n *Node) []map[string]interface{} {
+		pos++
+		if err != nil {
+			return nil
+		}
+		key, v, _ := lookupField(n.Data, column)
+		if v == nil {
+			return nil
+		}
+		rv := reflect.ValueOf(v)
+		if (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) || rv.Type().Elem().Kind() == reflect.Uint8 {
+			err = fmt.Errorf("row %d: %s value %v is not a slice", pos, column, v)
+			return nil
+		}
+		rows := make([]map[string]interface{}, rv.Len())
+		for i := range rows {
+			row := maps.Clone(n.Data)
+			row[key] = rv.Index(i).Interface()
+			rows[i] = row
+		}
+		return rows
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ll.SpillErr(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExplode(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "tags": []interface{}{"a", "b"}})
+	ll.Append(map[string]interface{}{"id": 2, "tags": nil})
+	ll.Append(map[string]interface{}{"id": 3, "tags": []string{}})
+	ll.Append(map[string]interface{}{"id": 4, "tags": []int64{7}})
+
+	out, err := ll.Explode("TAGS")
+	if err != nil {
+		t.Fatalf("Explode failed: %v", err)
+	}
+	if got := columnValues(out, "id"); !reflect.DeepEqual(got, []interface{}{1, 1, 4}) {
+		t.Errorf("Expected ids [1 1 4], got %v", got)
+	}
+	if got := columnValues(out, "tags"); !reflect.DeepEqual(got, []interface{}{"a", "b", int64(7)}) {
+		t.Errorf("Expected tags [a b 7], got %v", got)
+	}
+	if _, ok := ll.head.Data["tags"].([]interface{}); !ok {
+		t.Errorf("Expected the source rows unchanged, got %v", ll.head.Data["tags"])
+	}
+}
+
+func TestExplodeNonSlice(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"tags": []interface{}{"a"}})
+	ll.Append(map[string]interface{}{"tags": "a,b"})
+	if _, err := ll.Explode("tags"); err == nil {
+		t.Error("Expected error for a string value")
+	}
+}
@@ -0,0 +1,637 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// expr is a parsed expression evaluated against a row.
+type expr interface {
+	eval(row map[string]interface{}) (interface{}, error)
+}
+
+// token kinds produced by the lexer.
+const (
+	tokEOF = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokOp    // comparison operator
+	tokArith // arithmetic or concatenation operator
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind int
+	text string
+}
+
+// lexExpr splits src into tokens.
+func lexExpr(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			toks = append(toks, token{tokLParen, "("})
+			i++
+		case c == ')':
+			toks = append(toks, token{tokRParen, ")"})
+			i++
+		case c == ',':
+			toks = append(toks, token{tokComma, ","})
+			i++
+		case c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for {
+				if j >= len(src) {
+					return nil, fmt.Errorf("unterminated string at offset %d", i)
+				}
+				if src[j] == '\'' {
+					if j+1 < len(src) && src[j+1] == '\'' {
+						sb.WriteByte('\'')
+						j += 2
+						continue
+					}
+					break
+				}
+				sb.WriteByte(src[j])
+				j++
+			}
+			toks = append(toks, token{tokString, sb.String()})
+			i = j + 1
+		case c == '"' || c == '`':
+			end := strings.IndexByte(src[i+1:], src[i])
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated identifier at offset %d", i)
+			}
+			toks = append(toks, token{tokIdent, src[i+1 : i+1+end]})
+			i += end + 2
+		case unicode.IsDigit(c) || c == '.' && i+1 < len(src) && unicode.IsDigit(rune(src[i+1])):
+			j := i
+			for j < len(src) && (unicode.IsDigit(rune(src[j])) || src[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, src[i:j]})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(src) && (unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j])) || src[j] == '_' || src[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokIdent, src[i:j]})
+			i = j
+		case strings.HasPrefix(src[i:], "||"):
+			toks = append(toks, token{tokArith, "||"})
+			i += 2
+		case strings.ContainsRune("+-*/%", c):
+			toks = append(toks, token{tokArith, string(c)})
+			i++
+		default:
+			op := ""
+			for _, candidate := range []string{"<=", ">=", "<>", "!=", "=", "<", ">"} {
+				if strings.HasPrefix(src[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+			toks = append(toks, token{tokOp, op})
+			i += len(op)
+		}
+	}
+	return append(toks, token{kind: tokEOF}), nil
+}
+
+// parser is a recursive-descent parser over the token stream.
+type parser struct {
+	toks []token
+	pos  int
+}
+
+// parseExpr parses a boolean/scalar expression such as
+// "age > 30 AND city = 'NY'".
+func parseExpr(src string) (expr, error) {
+	toks, err := lexExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return e, nil
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) advance() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword consumes the next token if it is the given case-insensitive keyword.
+func (p *parser) keyword(kw string) bool {
+	t := p.peek()
+	if t.kind == tokIdent && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "OR", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "AND", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.keyword("NOT") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind == tokOp {
+		p.advance()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return &compareExpr{op: t.text, left: left, right: right}, nil
+	}
+
+	if p.keyword("IS") {
+		negate := p.keyword("NOT")
+		if !p.keyword("NULL") {
+			return nil, fmt.Errorf("expected NULL after IS")
+		}
+		return &isNullExpr{operand: left, negate: negate}, nil
+	}
+
+	negate := p.keyword("NOT")
+	switch {
+	case p.keyword("IN"):
+		if p.advance().kind != tokLParen {
+			return nil, fmt.Errorf("expected ( after IN")
+		}
+		var list []expr
+		for {
+			item, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+			if p.peek().kind == tokComma {
+				p.advance()
+				continue
+			}
+			if p.advance().kind != tokRParen {
+				return nil, fmt.Errorf("expected ) to close IN list")
+			}
+			break
+		}
+		return &inExpr{operand: left, list: list, negate: negate}, nil
+	case p.keyword("LIKE"):
+		pattern, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return &likeExpr{operand: left, pattern: pattern, negate: negate}, nil
+	case negate:
+		return nil, fmt.Errorf("expected IN or LIKE after NOT")
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAdditive() (expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokArith && (t.text == "+" || t.text == "-" || t.text == "||"); t = p.peek() {
+		p.advance()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithExpr{op: t.text, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokArith && (t.text == "*" || t.text == "/" || t.text == "%"); t = p.peek() {
+		p.advance()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithExpr{op: t.text, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if t := p.peek(); t.kind == tokArith && t.text == "-" {
+		p.advance()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &arithExpr{op: "-", left: &literalExpr{value: int64(0)}, right: operand}, nil
+	}
+	return p.parseOperand()
+}
+
+func (p *parser) parseOperand() (expr, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokNumber:
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return &literalExpr{value: i}, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.text)
+		}
+		return &literalExpr{value: f}, nil
+	case tokString:
+		return &literalExpr{value: t.text}, nil
+	case tokIdent:
+		switch strings.ToUpper(t.text) {
+		case "NULL":
+			return &literalExpr{value: nil}, nil
+		case "TRUE":
+			return &literalExpr{value: true}, nil
+		case "FALSE":
+			return &literalExpr{value: false}, nil
+		}
+		if p.peek().kind == tokLParen {
+			return p.parseCall(t.text)
+		}
+		return &columnExpr{name: t.text}, nil
+	case tokLParen:
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.advance().kind != tokRParen {
+			return nil, fmt.Errorf("expected )")
+		}
+		return e, nil
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+type literalExpr struct{ value interface{} }
+
+func (e *literalExpr) eval(map[string]interface{}) (interface{}, error) {
+	return e.value, nil
+}
+
+type columnExpr struct{ name string }
+
+func (e *columnExpr) eval(row map[string]interface{}) (interface{}, error) {
+	v, _ := lookupColumn(row, e.name)
+	return v, nil
+}
+
+// lookupColumn finds a column by exact name, falling back to a
+// case-insensitive match like StructScan does.
+func lookupColumn(row map[string]interface{}, name string) (interface{}, bool) {
+	if v, ok := row[name]; ok {
+		return v, true
+	}
+	for k, v := range row {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+type logicalExpr struct {
+	op          string
+	left, right expr
+}
+
+// eval applies SQL's three-valued logic: a NULL (unknown) operand makes the
+// result NULL unless the other operand decides it.
+func (e *logicalExpr) eval(row map[string]interface{}) (interface{}, error) {
+	// False decides AND and true decides OR, whatever the other operand is.
+	decisive := e.op == "OR"
+	l, err := evalLogic(e.left, row)
+	if err != nil || l == decisive {
+		return l, err
+	}
+	r, err := evalLogic(e.right, row)
+	if err != nil || r == decisive {
+		return r, err
+	}
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	return !decisive, nil
+}
+
+type notExpr struct{ operand expr }
+
+func (e *notExpr) eval(row map[string]interface{}) (interface{}, error) {
+	v, err := evalLogic(e.operand, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return !v.(bool), nil
+}
+
+type compareExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e *compareExpr) eval(row map[string]interface{}) (interface{}, error) {
+	l, err := e.left.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.right.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	// Comparisons with NULL are unknown, as in SQL.
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	c, err := compareValues(l, r)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "=":
+		return c == 0, nil
+	case "!=", "<>":
+		return c != 0, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	case ">=":
+		return c >= 0, nil
+	}
+	return nil, fmt.Errorf("unknown operator %q", e.op)
+}
+
+type isNullExpr struct {
+	operand expr
+	negate  bool
+}
+
+func (e *isNullExpr) eval(row map[string]interface{}) (interface{}, error) {
+	v, err := e.operand.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	return (v == nil) != e.negate, nil
+}
+
+type inExpr struct {
+	operand expr
+	list    []expr
+	negate  bool
+}
+
+func (e *inExpr) eval(row map[string]interface{}) (interface{}, error) {
+	v, err := e.operand.eval(row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	// Without a match, a NULL in the list makes the result unknown.
+	sawNull := false
+	for _, item := range e.list {
+		iv, err := item.eval(row)
+		if err != nil {
+			return nil, err
+		}
+		if iv == nil {
+			sawNull = true
+			continue
+		}
+		if c, err := compareValues(v, iv); err == nil && c == 0 {
+			return !e.negate, nil
+		}
+	}
+	if sawNull {
+		return nil, nil
+	}
+	return e.negate, nil
+}
+
+type likeExpr struct {
+	operand, pattern expr
+	negate           bool
+}
+
+func (e *likeExpr) eval(row map[string]interface{}) (interface{}, error) {
+	v, err := e.operand.eval(row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	p, err := e.pattern.eval(row)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	return matchLike(fmt.Sprint(v), fmt.Sprint(p)) != e.negate, nil
+}
+
+// matchLike implements SQL LIKE with % and _ wildcards, where _ matches one
+// character. It runs in O(len(s)*len(pattern)) time by backtracking only to
+// the most recent %.
+func matchLike(s, pattern string) bool {
+	str, pat := []rune(s), []rune(pattern)
+	i, j := 0, 0
+	star, mark := -1, 0
+	for i < len(str) {
+		switch {
+		case j < len(pat) && pat[j] == '%':
+			star, mark = j, i
+			j++
+		case j < len(pat) && (pat[j] == '_' || pat[j] == str[i]):
+			i++
+			j++
+		case star >= 0:
+			// Let the last % absorb one more character and retry.
+			mark++
+			i, j = mark, star+1
+		default:
+			return false
+		}
+	}
+	for j < len(pat) && pat[j] == '%' {
+		j++
+	}
+	return j == len(pat)
+}
+
+// evalBool evaluates e and requires a boolean result. NULL counts as false.
+func evalBool(e expr, row map[string]interface{}) (bool, error) {
+	v, err := evalLogic(e, row)
+	return v == true, err
+}
+
+// evalLogic evaluates e and requires a boolean or NULL result.
+func evalLogic(e expr, row map[string]interface{}) (interface{}, error) {
+	v, err := e.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	switch v.(type) {
+	case nil, bool:
+		return v, nil
+	}
+	return nil, fmt.Errorf("expected boolean, got %T", v)
+}
+
+// compareValues orders two non-nil values, returning -1, 0 or 1. Numbers are
+// compared by value, exactly when both are integers, times chronologically
+// (strings are parsed as RFC 3339 when compared with a time), and strings
+// and booleans naturally.
+func compareValues(a, b interface{}) (int, error) {
+	if ab, ok := a.([]byte); ok {
+		a = string(ab)
+	}
+	if bb, ok := b.([]byte); ok {
+		b = string(bb)
+	}
+
+	if ai, ok := toInt64(a); ok {
+		if bi, ok := toInt64(b); ok {
+			switch {
+			case ai < bi:
+				return -1, nil
+			case ai > bi:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	if af, ok := toFloat64(a); ok {
+		if bf, ok := toFloat64(b); ok {
+			switch {
+			case af < bf:
+				return -1, nil
+			case af > bf:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+
+	at, aIsTime := a.(time.Time)
+	bt, bIsTime := b.(time.Time)
+	if aIsTime || bIsTime {
+		var err error
+		if !aIsTime {
+			if at, err = parseTimeValue(a); err != nil {
+				return 0, err
+			}
+		}
+		if !bIsTime {
+			if bt, err = parseTimeValue(b); err != nil {
+				return 0, err
+			}
+		}
+		return at.Compare(bt), nil
+	}
+
+	switch av := a.(type) {
+	case string:
+		if bv, ok := b.(string); ok {
+			return strings.Compare(av, bv), nil
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			switch {
+			case av == bv:
+				return 0, nil
+			case !av:
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+}
+
+// parseTimeValue parses a string as an RFC 3339 time.
+func parseTimeValue(v interface{}) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("cannot compare %T with time.Time", v)
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,284 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// arithExpr applies +, -, *, /, % or || to two operands. NULL operands give
+// NULL, as in SQL.
+type arithExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e *arithExpr) eval(row map[string]interface{}) (interface{}, error) {
+	l, err := e.left.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.right.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	if e.op == "||" {
+		return valueText(l) + valueText(r), nil
+	}
+
+	li, lInt := toInt64(l)
+	ri, rInt := toInt64(r)
+	if lInt && rInt && e.op != "/" {
+		switch e.op {
+		case "+":
+			return checkedInt(addInt64(li, ri))
+		case "-":
+			return checkedInt(subInt64(li, ri))
+		case "*":
+			return checkedInt(mulInt64(li, ri))
+		case "%":
+			if ri == 0 {
+				return nil, errors.New("division by zero")
+			}
+			return li % ri, nil
+		}
+	}
+	lf, lok := toFloat64(l)
+	rf, rok := toFloat64(r)
+	if !lok || !rok {
+		return nil, fmt.Errorf("cannot apply %s to %T and %T", e.op, l, r)
+	}
+	switch e.op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return lf / rf, nil
+	case "%":
+		if rf == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return math.Mod(lf, rf), nil
+	}
+	return nil, fmt.Errorf("unknown operator %q", e.op)
+}
+
+// errIntegerOverflow is returned when integer arithmetic leaves the int64
+// range, as SQL databases do instead of wrapping around.
+var errIntegerOverflow = errors.New("integer out of range")
+
+func checkedInt(n int64, ok bool) (interface{}, error) {
+	if !ok {
+		return nil, errIntegerOverflow
+	}
+	return n, nil
+}
+
+// addInt64 returns a+b and whether it did not overflow.
+func addInt64(a, b int64) (int64, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+// subInt64 returns a-b and whether it did not overflow.
+func subInt64(a, b int64) (int64, bool) {
+	d := a - b
+	return d, (d < a) == (b > 0)
+}
+
+// mulInt64 returns a*b and whether it did not overflow.
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	return p, p/b == a && !(a == -1 && b == math.MinInt64) && !(b == -1 && a == math.MinInt64)
+}
+
+// exprFunc is a function callable from expressions. Its arguments are
+// already evaluated.
+type exprFunc struct {
+	minArgs, maxArgs int // maxArgs < 0 means variadic
+	fn               func(args []interface{}) (interface{}, error)
+}
+
+// exprFuncs are the functions available in expressions, by lower-case name.
+var exprFuncs = map[string]exprFunc{
+	"concat": {1, -1, func(args []interface{}) (interface{}, error) {
+		var sb strings.Builder
+		for _, a := range args {
+			if a != nil {
+				sb.WriteString(valueText(a))
+			}
+		}
+		return sb.String(), nil
+	}},
+	"coalesce": {1, -1, func(args []interface{}) (interface{}, error) {
+		for _, a := range args {
+			if a != nil {
+				return a, nil
+			}
+		}
+		return nil, nil
+	}},
+	"upper": {1, 1, stringFunc(strings.ToUpper)},
+	"lower": {1, 1, stringFunc(strings.ToLower)},
+	"trim":  {1, 1, stringFunc(strings.TrimSpace)},
+	"length": {1, 1, nullable(func(args []interface{}) (interface{}, error) {
+		return int64(utf8.RuneCountInString(valueText(args[0]))), nil
+	})},
+	"substr": {2, 3, nullable(substr)},
+	"abs": {1, 1, nullable(func(args []interface{}) (interface{}, error) {
+		if i, ok := toInt64(args[0]); ok {
+			if i < 0 {
+				return -i, nil
+			}
+			return i, nil
+		}
+		f, err := numberArg(args[0])
+		return math.Abs(f), err
+	})},
+	"round": {1, 2, nullable(func(args []interface{}) (interface{}, error) {
+		f, err := numberArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		digits := int64(0)
+		if len(args) == 2 {
+			var ok bool
+			if digits, ok = toInt64(args[1]); !ok {
+				return nil, fmt.Errorf("round digits must be an integer, got %T", args[1])
+			}
+		}
+		scale := math.Pow(10, float64(digits))
+		return math.Round(f*scale) / scale, nil
+	})},
+	"floor": {1, 1, floatFunc(math.Floor)},
+	"ceil":  {1, 1, floatFunc(math.Ceil)},
+}
+
+// nullable wraps fn to return NULL when any argument is NULL.
+func nullable(fn func(args []interface{}) (interface{}, error)) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		for _, a := range args {
+			if a == nil {
+				return nil, nil
+			}
+		}
+		return fn(args)
+	}
+}
+
+// stringFunc adapts a string transformation to a one-argument function.
+func stringFunc(f func(string) string) func(args []interface{}) (interface{}, error) {
+	return nullable(func(args []interface{}) (interface{}, error) {
+		return f(valueText(args[0])), nil
+	})
+}
+
+// floatFunc adapts a float transformation to a one-argument function.
+func floatFunc(f func(float64) float64) func(args []interface{}) (interface{}, error) {
+	return nullable(func(args []interface{}) (interface{}, error) {
+		x, err := numberArg(args[0])
+		return f(x), err
+	})
+}
+
+// numberArg converts a function argument to float64.
+func numberArg(v interface{}) (float64, error) {
+	f, ok := toFloat64(v)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+	return f, nil
+}
+
+// substr returns the substring of args[0] starting at the 1-based rune
+// position args[1], of at most args[2] runes when given.
+func substr(args []interface{}) (interface{}, error) {
+	runes := []rune(valueText(args[0]))
+	start, ok := toInt64(args[1])
+	if !ok {
+		return nil, fmt.Errorf("substr start must be an integer, got %T", args[1])
+	}
+	from := max(int(start)-1, 0)
+	to := len(runes)
+	if len(args) == 3 {
+		n, ok := toInt64(args[2])
+		if !ok || n < 0 {
+			return nil, fmt.Errorf("substr length must be a non-negative integer, got %v", args[2])
+		}
+		to = min(int(start)-1+int(n), len(runes))
+	}
+	if from >= to {
+		return "", nil
+	}
+	return string(runes[from:to]), nil
+}
+
+// callExpr calls a function from exprFuncs.
+type callExpr struct {
+	name string
+	fn   exprFunc
+	args []expr
+}
+
+func (e *callExpr) eval(row map[string]interface{}) (interface{}, error) {
+	args := make([]interface{}, len(e.args))
+	for i, a := range e.args {
+		v, err := a.eval(row)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := e.fn.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", e.name, err)
+	}
+	return v, nil
+}
+
+// parseCall parses the parenthesised argument list of a call to name.
+func (p *parser) parseCall(name string) (expr, error) {
+	fn, ok := exprFuncs[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	p.advance() // (
+	var args []expr
+	if p.peek().kind == tokRParen {
+		p.advance()
+	} else {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.peek().kind == tokComma {
+				p.advance()
+				continue
+			}
+			if p.advance().kind != tokRParen {
+				return nil, fmt.Errorf("expected ) to close %s(", name)
+			}
+			break
+		}
+	}
+	if len(args) < fn.minArgs || fn.maxArgs >= 0 && len(args) > fn.maxArgs {
+		return nil, fmt.Errorf("wrong number of arguments to %s: %d", name, len(args))
+	}
+	return &callExpr{name: strings.ToLower(name), fn: fn, args: args}, nil
+}
@@ -0,0 +1,113 @@
+// Package fake generates linked lists of realistic-looking data for load
+// tests and benchmarks.
+package fake
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// Generator produces the value of a column for row i, drawing any randomness
+// from rng.
+type Generator func(rng *rand.Rand, i int) interface{}
+
+// Generate returns a list of n rows with a column per schema entry. Output
+// is deterministic for a given schema; use GenerateSeed for other data.
+func Generate(n int, schema map[string]Generator) *linkedlist.LinkedList {
+	return GenerateSeed(n, schema, 1)
+}
+
+// GenerateSeed is Generate with an explicit random seed.
+func GenerateSeed(n int, schema map[string]Generator, seed int64) *linkedlist.LinkedList {
+	columns := make([]string, 0, len(schema))
+	for c := range schema {
+		columns = append(columns, c)
+	}
+	sort.Strings(columns)
+
+	rng := rand.New(rand.NewSource(seed))
+	ll := linkedlist.New()
+	for i := 0; i < n; i++ {
+		row := make(map[string]interface{}, len(columns))
+		for _, c := range columns {
+			row[c] = schema[c](rng, i)
+		}
+		ll.Append(row)
+	}
+	return ll
+}
+
+// Sequence yields start, start+1, ... as int64.
+func Sequence(start int64) Generator {
+	return func(_ *rand.Rand, i int) interface{} {
+		return start + int64(i)
+	}
+}
+
+// IntBetween yields int64 values in [lo, hi].
+func IntBetween(lo, hi int64) Generator {
+	return func(rng *rand.Rand, _ int) interface{} {
+		return lo + rng.Int63n(hi-lo+1)
+	}
+}
+
+// FloatBetween yields float64 values in [lo, hi).
+func FloatBetween(lo, hi float64) Generator {
+	return func(rng *rand.Rand, _ int) interface{} {
+		return lo + rng.Float64()*(hi-lo)
+	}
+}
+
+var (
+	firstNames = []string{"Alice", "Bob", "Carol", "Dan", "Erin", "Frank", "Grace", "Heidi", "Ivan", "Judy", "Mallory", "Niaj", "Olivia", "Peggy", "Rupert", "Sybil", "Trent", "Victor", "Walter", "Yuki"}
+	lastNames  = []string{"Smith", "Garcia", "Chen", "Okafor", "Müller", "Rossi", "Kowalski", "Silva", "Tanaka", "Nguyen", "Haddad", "Johansson", "Dubois", "Kim", "Patel"}
+	domains    = []string{"example.com", "example.org", "example.net"}
+)
+
+// Names yields "First Last" full names.
+func Names() Generator {
+	return func(rng *rand.Rand, _ int) interface{} {
+		return firstNames[rng.Intn(len(firstNames))] + " " + lastNames[rng.Intn(len(lastNames))]
+	}
+}
+
+// Emails yields addresses at reserved example domains, unique per row.
+func Emails() Generator {
+	return func(rng *rand.Rand, i int) interface{} {
+		first := strings.ToLower(firstNames[rng.Intn(len(firstNames))])
+		return fmt.Sprintf("%s.%d@%s", first, i, domains[rng.Intn(len(domains))])
+	}
+}
+
+// TimeBetween yields times in [from, to), truncated to the second.
+func TimeBetween(from, to time.Time) Generator {
+	span := int64(to.Sub(from))
+	return func(rng *rand.Rand, _ int) interface{} {
+		if span <= 0 {
+			return from
+		}
+		return from.Add(time.Duration(rng.Int63n(span))).Truncate(time.Second)
+	}
+}
+
+// OneOf picks one of values uniformly.
+func OneOf(values ...interface{}) Generator {
+	return func(rng *rand.Rand, _ int) interface{} {
+		return values[rng.Intn(len(values))]
+	}
+}
+
+// Nullable makes g yield nil for about rate (0..1) of the rows.
+func Nullable(g Generator, rate float64) Generator {
+	return func(rng *rand.Rand, i int) interface{} {
+		if rng.Float64() < rate {
+			return nil
+		}
+		return g(rng, i)
+	}
+}
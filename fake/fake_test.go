@@ -0,0 +1,75 @@
+package fake
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+func usersSchema() map[string]Generator {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return map[string]Generator{
+		"id":      Sequence(100),
+		"name":    Names(),
+		"email":   Emails(),
+		"created": TimeBetween(from, from.AddDate(0, 1, 0)),
+		"plan":    OneOf("free", "pro", "team"),
+		"age":     IntBetween(18, 90),
+		"score":   Nullable(FloatBetween(0, 1), 0.5),
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	ll := Generate(500, usersSchema())
+	if ll.Len() != 500 {
+		t.Fatalf("Expected 500 rows, got %d", ll.Len())
+	}
+
+	type user struct {
+		ID      int64
+		Name    string
+		Email   string
+		Created time.Time
+		Plan    string
+		Age     int
+		Score   *float64
+	}
+	var users []user
+	if err := ll.ToSlice(&users); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	nulls := 0
+	for i, u := range users {
+		if u.ID != int64(100+i) {
+			t.Fatalf("Expected sequential IDs, got %d at %d", u.ID, i)
+		}
+		if !strings.Contains(u.Name, " ") || !strings.Contains(u.Email, "@example.") {
+			t.Errorf("Unexpected name or email: %q, %q", u.Name, u.Email)
+		}
+		if u.Created.Month() != time.January || u.Age < 18 || u.Age > 90 {
+			t.Errorf("Value out of range: %+v", u)
+		}
+		if u.Plan != "free" && u.Plan != "pro" && u.Plan != "team" {
+			t.Errorf("Unexpected plan %q", u.Plan)
+		}
+		if u.Score == nil {
+			nulls++
+		}
+	}
+	if nulls < 200 || nulls > 300 {
+		t.Errorf("Expected about half the scores to be NULL, got %d", nulls)
+	}
+}
+
+func TestGenerate_Deterministic(t *testing.T) {
+	a := Generate(50, usersSchema())
+	b := Generate(50, usersSchema())
+	if !linkedlist.Equal(a, b) {
+		t.Error("Expected identical output for the same schema")
+	}
+	if linkedlist.Equal(a, GenerateSeed(50, usersSchema(), 2)) {
+		t.Error("Expected a different seed to change the data")
+	}
+}
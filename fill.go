@@ -0,0 +1,130 @@
+package linkedlist
+
+import "fmt"
+
+// fillKind identifies a FillStrategy.
+type fillKind int
+
+const (
+	fillConstant fillKind = iota
+	fillForward
+	fillBackward
+	fillMean
+	fillLinear
+)
+
+// FillStrategy selects how FillNulls replaces missing values.
+type FillStrategy struct {
+	kind  fillKind
+	value interface{}
+}
+
+var (
+	// FillForward copies the previous non-NULL value down. Leading NULLs
+	// stay NULL.
+	FillForward = FillStrategy{kind: fillForward}
+	// FillBackward copies the next non-NULL value up. Trailing NULLs stay
+	// NULL.
+	FillBackward = FillStrategy{kind: fillBackward}
+	// FillMean uses the mean of the column's numeric values as a float64.
+	FillMean = FillStrategy{kind: fillMean}
+	// FillLinear interpolates numerically between the surrounding non-NULL
+	// values by row position, as float64. Leading and trailing NULLs stay
+	// NULL.
+	FillLinear = FillStrategy{kind: fillLinear}
+)
+
+// FillConstant fills with value.
+func FillConstant(value interface{}) FillStrategy {
+	return FillStrategy{kind: fillConstant, value: value}
+}
+
+// FillNulls replaces NULL and missing values of column in list order using
+// strategy. FillMean and FillLinear fail on non-numeric values, leaving the
+// list unchanged.
+func (ll *LinkedList) FillNulls(column string, strategy FillStrategy) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	var nodes []*Node
+	for n := ll.front(); n != nil; n = n.succ() {
+		nodes = append(nodes, n)
+	}
+	fills := make([]interface{}, len(nodes))
+	isNull := func(i int) bool { return nodes[i].Data[column] == nil }
+
+	switch strategy.kind {
+	case fillConstant:
+		for i := range nodes {
+			fills[i] = strategy.value
+		}
+	case fillForward:
+		var last interface{}
+		for i, n := range nodes {
+			if !isNull(i) {
+				last = n.Data[column]
+			}
+			fills[i] = last
+		}
+	case fillBackward:
+		var next interface{}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !isNull(i) {
+				next = nodes[i].Data[column]
+			}
+			fills[i] = next
+		}
+	case fillMean, fillLinear:
+		values := make([]float64, len(nodes))
+		var known []int
+		for i, n := range nodes {
+			if isNull(i) {
+				continue
+			}
+			f, ok := toFloat64(n.Data[column])
+			if !ok {
+				return fmt.Errorf("row %d: %s value %v is not numeric", i+1, column, n.Data[column])
+			}
+			values[i] = f
+			known = append(known, i)
+		}
+		if len(known) == 0 {
+			break
+		}
+		if strategy.kind == fillMean {
+			sum := 0.0
+			for _, i := range known {
+				sum += values[i]
+			}
+			for i := range nodes {
+				fills[i] = sum / float64(len(known))
+			}
+			break
+		}
+		for k := 1; k < len(known); k++ {
+			a, b := known[k-1], known[k]
+			for i := a + 1; i < b; i++ {
+				fills[i] = values[a] + (values[b]-values[a])*float64(i-a)/float64(b-a)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown fill strategy %d", strategy.kind)
+	}
+
+	filled := 0
+	for i, n := range nodes {
+		if isNull(i) && fills[i] != nil {
+			n.Set(column, fills[i])
+			filled++
+		}
+	}
+	ll.record("update", filled, "FillNulls %s", column)
+	return nil
+}
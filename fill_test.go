@@ -0,0 +1,76 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func fillList(values ...interface{}) *LinkedList {
+	ll := New()
+	for _, v := range values {
+		row := map[string]interface{}{}
+		if v != "missing" {
+			row["x"] = v
+		}
+		ll.Append(row)
+	}
+	return ll
+}
+
+func columnValues(ll *LinkedList, column string) []interface{} {
+	var out []interface{}
+	for n := ll.First(); n != nil; n = n.next {
+		out = append(out, n.Data[column])
+	}
+	return out
+}
+
+func TestFillNulls(t *testing.T) {
+	cases := []struct {
+		name     string
+		strategy FillStrategy
+		want     []interface{}
+	}{
+		{"constant", FillConstant(0), []interface{}{0, 1, 0, 0, 4, 0}},
+		{"forward", FillForward, []interface{}{nil, 1, 1, 1, 4, 4}},
+		{"backward", FillBackward, []interface{}{1, 1, 4, 4, 4, nil}},
+		{"mean", FillMean, []interface{}{2.5, 1, 2.5, 2.5, 4, 2.5}},
+		{"linear", FillLinear, []interface{}{nil, 1, 2.0, 3.0, 4, nil}},
+	}
+	for _, c := range cases {
+		ll := fillList(nil, 1, nil, "missing", 4, nil)
+		if err := ll.FillNulls("x", c.strategy); err != nil {
+			t.Fatalf("%s: FillNulls failed: %v", c.name, err)
+		}
+		if got := columnValues(ll, "x"); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: Expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestFillNullsNonNumeric(t *testing.T) {
+	ll := fillList("a", nil)
+	if err := ll.FillNulls("x", FillMean); err == nil {
+		t.Error("Expected error for non-numeric mean, got nil")
+	}
+	if ll.Last().Data["x"] != nil {
+		t.Errorf("Expected list unchanged, got %v", ll.Last().Data["x"])
+	}
+	if err := ll.FillNulls("x", FillForward); err != nil {
+		t.Errorf("Expected forward fill to accept strings, got %v", err)
+	}
+}
+
+func TestFillNullsKeepsFrozenViewIntact(t *testing.T) {
+	ll := fillList(1, nil)
+	view := ll.Freeze()
+	if err := ll.FillNulls("x", FillForward); err != nil {
+		t.Fatalf("FillNulls failed: %v", err)
+	}
+	if view.Last().Data["x"] != nil {
+		t.Errorf("Expected frozen view unchanged, got %v", view.Last().Data["x"])
+	}
+	if err := view.FillNulls("x", FillForward); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package linkedlist
+
+import "fmt"
+
+// Filter returns a new list with the nodes for which keep returns true. The
+// returned nodes share Data with the original list until either side changes
+// it through the package API (copy-on-write). Spilled rows are included; an
+// error reading them ends the result early and is reported by SpillErr.
+func (ll *LinkedList) Filter(keep func(*Node) bool) *LinkedList {
+	result := New()
+	if ll == nil {
+		return result
+	}
+	ll.each(func(n *Node) error {
+		if keep(n) {
+			result.appendNode(n.shareCopy())
+		}
+		return nil
+	})
+	ll.recordDerived(result, "Filter")
+	return result
+}
+
+// Where returns a new list with the rows matching a SQL-like condition such
+// as "age > 30 AND city = 'NY'". Supported are the comparison operators
+// =, !=, <>, <, <=, >, >=, the keywords AND, OR, NOT, IS [NOT] NULL,
+// [NOT] IN (...) and [NOT] LIKE, parentheses, and number, 'string', TRUE,
+// FALSE and NULL literals. Operands may use the arithmetic and functions
+// described at AddComputedColumn. Column names resolve like StructScan
+// field names.
+// Like Filter, the result shares Data with ll until either side changes it.
+func (ll *LinkedList) Where(condition string) (*LinkedList, error) {
+	e, err := parseExpr(condition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition %q: %w", condition, err)
+	}
+
+	result := New()
+	if ll == nil {
+		return result, nil
+	}
+	pos := 0
+	err = ll.each(func(n *Node) error {
+		pos++
+		ok, err := evalBool(e, n.Data)
+		if err != nil {
+			return fmt.Errorf("error evaluating row %d: %w", pos, err)
+		}
+		if ok {
+			result.appendNode(n.shareCopy())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ll.recordDerived(result, "Where %s", condition)
+	return result, nil
+}
+
+// Select returns a new list holding only the given columns of every row,
+// spilled ones included. Missing columns are omitted from the projected
+// rows. Read errors are reported by ll's SpillErr.
+func (ll *LinkedList) Select(columns ...string) *LinkedList {
+	result := New()
+	ll.each(func(n *Node) error {
+		row := make(map[string]interface{}, len(columns))
+		for _, col := range columns {
+			if v, ok := n.Data[col]; ok {
+				row[col] = v
+			}
+		}
+		result.appendNode(n.derive(row))
+		return nil
+	})
+	ll.recordDerived(result, "Select %s", auditColumns(columns))
+	return result
+}
@@ -0,0 +1,116 @@
+package linkedlist
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func peopleList() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "Alice", "age": int64(34), "city": "NY"})
+	ll.Append(map[string]interface{}{"name": "Bob", "age": 28, "city": "NY"})
+	ll.Append(map[string]interface{}{"name": "Carol", "age": 41.5, "city": "LA"})
+	ll.Append(map[string]interface{}{"name": "Dan", "age": nil, "city": "NY"})
+	return ll
+}
+
+func names(ll *LinkedList) []string {
+	var out []string
+	for n := ll.First(); n != nil; n = n.next {
+		out = append(out, n.Data["name"].(string))
+	}
+	return out
+}
+
+func TestFilter(t *testing.T) {
+	got := peopleList().Filter(func(n *Node) bool { return n.Data["city"] == "LA" })
+	if got.Len() != 1 || got.First().Data["name"] != "Carol" {
+		t.Errorf("Unexpected filter result: %v", names(got))
+	}
+}
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		cond string
+		want []string
+	}{
+		{"age > 30 AND city = 'NY'", []string{"Alice"}},
+		{"age >= 28 OR city = 'LA'", []string{"Alice", "Bob", "Carol"}},
+		{"NOT (city = 'NY')", []string{"Carol"}},
+		{"age IS NULL", []string{"Dan"}},
+		{"age IS NOT NULL AND age < 30", []string{"Bob"}},
+		{"name IN ('Bob', 'Dan')", []string{"Bob", "Dan"}},
+		{"name LIKE '%a%'", []string{"Carol", "Dan"}},
+		{"CITY <> 'NY'", []string{"Carol"}},
+		{"NOT (age > 30)", []string{"Bob"}},
+		{"NOT (age > 30 AND city = 'LA')", []string{"Alice", "Bob", "Dan"}},
+		{"age > 30 OR city = 'NY'", []string{"Alice", "Bob", "Carol", "Dan"}},
+		{"age NOT IN (28, NULL)", nil},
+		{"name LIKE '_o%'", []string{"Bob"}},
+	}
+	for _, tt := range tests {
+		got, err := peopleList().Where(tt.cond)
+		if err != nil {
+			t.Fatalf("Where(%q) failed: %v", tt.cond, err)
+		}
+		gotNames := names(got)
+		if len(gotNames) != len(tt.want) {
+			t.Errorf("Where(%q) = %v, want %v", tt.cond, gotNames, tt.want)
+			continue
+		}
+		for i := range gotNames {
+			if gotNames[i] != tt.want[i] {
+				t.Errorf("Where(%q) = %v, want %v", tt.cond, gotNames, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestMatchLike(t *testing.T) {
+	tests := []struct {
+		s, pattern string
+		want       bool
+	}{
+		{"café", "caf_", true},
+		{"café", "caf__", false},
+		{"abc", "a%c", true},
+		{"abc", "%b%", true},
+		{"abc", "%d%", false},
+		{"", "%", true},
+		{"ab", "%a_b", false},
+		{"aab", "%ab", true},
+		{strings.Repeat("a", 40), strings.Repeat("%a", 20) + "b", false},
+	}
+	for _, tt := range tests {
+		if got := matchLike(tt.s, tt.pattern); got != tt.want {
+			t.Errorf("matchLike(%q, %q) = %v, want %v", tt.s, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestWhere_Times(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "old", "at": time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	ll.Append(map[string]interface{}{"name": "new", "at": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	got, err := ll.Where("at > '2022-01-01T00:00:00Z'")
+	if err != nil {
+		t.Fatalf("Where failed: %v", err)
+	}
+	if got.Len() != 1 || got.First().Data["name"] != "new" {
+		t.Errorf("Unexpected result: %v", names(got))
+	}
+}
+
+func TestWhere_Errors(t *testing.T) {
+	for _, cond := range []string{"age >", "age = 'x", "(age > 1", "age ~ 1"} {
+		if _, err := peopleList().Where(cond); err == nil {
+			t.Errorf("Expected parse error for %q", cond)
+		}
+	}
+	if _, err := peopleList().Where("name > 3"); err == nil {
+		t.Error("Expected evaluation error comparing string with number")
+	}
+}
@@ -0,0 +1,101 @@
+// Package fixtures builds linked lists for tests, from fixture files or
+// with a fluent row builder.
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// Builder accumulates rows for a list:
+//
+//	ll := fixtures.NewBuilder().
+//		NewRow().Set("id", 1).Set("name", "Alice").Done().
+//		NewRow().Set("id", 2).Set("name", "Bob").Done().
+//		List()
+type Builder struct {
+	defaults map[string]interface{}
+	rows     []map[string]interface{}
+}
+
+// NewBuilder returns an empty builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Defaults sets values copied into every row started afterwards, which
+// rows may override with Set.
+func (b *Builder) Defaults(values map[string]interface{}) *Builder {
+	b.defaults = values
+	return b
+}
+
+// NewRow starts a row.
+func (b *Builder) NewRow() *RowBuilder {
+	row := make(map[string]interface{}, len(b.defaults))
+	for k, v := range b.defaults {
+		row[k] = v
+	}
+	return &RowBuilder{b: b, row: row}
+}
+
+// List returns a new list holding the finished rows.
+func (b *Builder) List() *linkedlist.LinkedList {
+	ll := linkedlist.New()
+	for _, row := range b.rows {
+		copied := make(map[string]interface{}, len(row))
+		for k, v := range row {
+			copied[k] = v
+		}
+		ll.Append(copied)
+	}
+	return ll
+}
+
+// RowBuilder sets the columns of a single row.
+type RowBuilder struct {
+	b   *Builder
+	row map[string]interface{}
+}
+
+// Set stores value under column.
+func (r *RowBuilder) Set(column string, value interface{}) *RowBuilder {
+	r.row[column] = value
+	return r
+}
+
+// Done finishes the row and returns its builder.
+func (r *RowBuilder) Done() *Builder {
+	r.b.rows = append(r.b.rows, r.row)
+	return r.b
+}
+
+// FromJSON reads a JSON array of objects into a list. Integral numbers
+// become int64 and other numbers float64, as SQL drivers return them.
+func FromJSON(r io.Reader) (*linkedlist.LinkedList, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var rows []map[string]interface{}
+	if err := dec.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("invalid JSON fixture: %w", err)
+	}
+	ll := linkedlist.New()
+	for i, row := range rows {
+		if row == nil {
+			return nil, fmt.Errorf("invalid JSON fixture: item %d is not an object", i)
+		}
+		if _, err := linkedlist.DecodeJSONNumbers(row); err != nil {
+			return nil, fmt.Errorf("invalid JSON fixture: item %d: %w", i, err)
+		}
+		ll.Append(row)
+	}
+	return ll, nil
+}
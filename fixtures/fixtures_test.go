@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	ll := NewBuilder().
+		Defaults(map[string]interface{}{"active": true}).
+		NewRow().Set("id", 1).Set("name", "Alice").Done().
+		NewRow().Set("id", 2).Set("active", false).Done().
+		List()
+
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", ll.Len())
+	}
+	if ll.First().Data["name"] != "Alice" || ll.First().Data["active"] != true {
+		t.Errorf("Unexpected first row: %v", ll.First().Data)
+	}
+	if ll.Last().Data["active"] != false {
+		t.Errorf("Expected override of default, got %v", ll.Last().Data)
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	ll, err := FromJSON(strings.NewReader(`[{"id": 1, "score": 2.5, "tags": ["a"]}, {"id": 2, "score": null}]`))
+	if err != nil {
+		t.Fatalf("FromJSON failed: %v", err)
+	}
+	if ll.Len() != 2 || ll.First().Data["id"] != int64(1) || ll.First().Data["score"] != 2.5 {
+		t.Errorf("Unexpected rows: %v", ll.First().Data)
+	}
+	if _, err := FromJSON(strings.NewReader(`{"id": 1}`)); err == nil {
+		t.Error("Expected error for a non-array fixture")
+	}
+}
@@ -0,0 +1,128 @@
+package fixtures
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// errYAML reports YAML outside the subset FromYAML understands.
+var errYAML = errors.New("unsupported YAML")
+
+// FromYAML reads a YAML fixture into a list. It understands the subset
+// fixture files use: a top-level sequence of flat mappings with scalar
+// values, plus comments and blank lines:
+//
+//	# users
+//	- id: 1
+//	  name: Alice
+//	  email: null
+//	- id: 2
+//	  name: "Bob, Jr."
+//
+// Scalars follow YAML 1.2 core rules: null and ~ are nil, true/false are
+// bool, integers int64, other numbers float64, and anything else a string,
+// optionally quoted.
+func FromYAML(r io.Reader) (*linkedlist.LinkedList, error) {
+	ll := linkedlist.New()
+	var row map[string]interface{}
+	indent := -1
+	sc := bufio.NewScanner(r)
+	for lineNo := 1; sc.Scan(); lineNo++ {
+		line := stripComment(sc.Text())
+		if strings.TrimSpace(line) == "" || strings.TrimSpace(line) == "---" {
+			continue
+		}
+		trimmed := strings.TrimLeft(line, " ")
+		col := len(line) - len(trimmed)
+
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			if col != 0 {
+				return nil, fmt.Errorf("line %d: %w: nested sequence", lineNo, errYAML)
+			}
+			if row != nil {
+				ll.Append(row)
+			}
+			row = make(map[string]interface{})
+			trimmed = strings.TrimLeft(strings.TrimPrefix(trimmed, "-"), " ")
+			indent = len(line) - len(trimmed)
+			if trimmed == "" {
+				continue
+			}
+		} else if row == nil || col != indent {
+			return nil, fmt.Errorf("line %d: %w: expected a sequence of mappings", lineNo, errYAML)
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok || (value != "" && value[0] != ' ') {
+			return nil, fmt.Errorf("line %d: %w: expected key: value", lineNo, errYAML)
+		}
+		v, err := yamlScalar(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		row[strings.TrimSpace(key)] = v
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if row != nil {
+		ll.Append(row)
+	}
+	return ll, nil
+}
+
+// stripComment removes a # comment that is not inside quotes.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' '):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// yamlScalar converts a plain or quoted scalar.
+func yamlScalar(s string) (interface{}, error) {
+	switch {
+	case s == "" || s == "~" || s == "null" || s == "Null" || s == "NULL":
+		return nil, nil
+	case s == "true" || s == "True" || s == "TRUE":
+		return true, nil
+	case s == "false" || s == "False" || s == "FALSE":
+		return false, nil
+	case s[0] == '"':
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted string %s", s)
+		}
+		return v, nil
+	case s[0] == '\'':
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return nil, fmt.Errorf("invalid quoted string %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	case s[0] == '[' || s[0] == '{' || s[0] == '|' || s[0] == '>' || s[0] == '&' || s[0] == '*':
+		return nil, fmt.Errorf("%w: %s", errYAML, s)
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, nil
+	}
+	return s, nil
+}
@@ -0,0 +1,47 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFromYAML(t *testing.T) {
+	src := `# users
+---
+- id: 1
+  name: Alice   # admin
+  email: null
+  score: 9.5
+  active: true
+- id: 2
+  name: "Bob, Jr. # not a comment"
+  nick: 'it''s'
+  email: ~
+`
+	ll, err := FromYAML(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("FromYAML failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", ll.Len())
+	}
+	first, last := ll.First().Data, ll.Last().Data
+	if first["id"] != int64(1) || first["name"] != "Alice" || first["email"] != nil || first["score"] != 9.5 || first["active"] != true {
+		t.Errorf("Unexpected first row: %v", first)
+	}
+	if last["name"] != "Bob, Jr. # not a comment" || last["nick"] != "it's" {
+		t.Errorf("Unexpected second row: %v", last)
+	}
+}
+
+func TestFromYAML_Unsupported(t *testing.T) {
+	for _, src := range []string{
+		"id: 1\n",
+		"- id: 1\n  tags: [a, b]\n",
+		"- id: 1\n    name: x\n",
+	} {
+		if _, err := FromYAML(strings.NewReader(src)); err == nil {
+			t.Errorf("Expected error for %q", src)
+		}
+	}
+}
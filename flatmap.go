@@ -0,0 +1,17 @@
+package linkedlist
+
+// FlatMap returns a new list with the rows fn returns for each row, in
+// order. fn may return any number of rows, none dropping the input row.
+// The new rows keep the provenance of the row they came from, see Node.
+// Spilled rows are included; read errors are reported by SpillErr.
+func (ll *LinkedList) FlatMap(fn func(*Node) []map[string]interface{}) *LinkedList {
+	result := New()
+	ll.each(func(n *Node) error {
+		for _, row := range fn(n) {
+			result.appendNode(n.derive(row))
+		}
+		return nil
+	})
+	ll.recordDerived(result, "FlatMap")
+	return result
+}
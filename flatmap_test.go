@@ -0,0 +1,39 @@
+package linkedlist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "tags": "a,b"})
+	ll.Append(map[string]interface{}{"id": 2, "tags": ""})
+	ll.Append(map[string]interface{}{"id": 3, "tags": "c"})
+	ll.head.rowNum = 7
+
+	out := ll.FlatMap(func(n *Node) []map[string]interface{} {
+		var rows []map[string]interface{}
+		for _, tag := range strings.Split(n.Data["tags"].(string), ",") {
+			if tag != "" {
+				rows = append(rows, map[string]interface{}{"id": n.Data["id"], "tag": tag})
+			}
+		}
+		return rows
+	})
+	if got := columnValues(out, "tag"); !reflect.DeepEqual(got, []interface{}{"a", "b", "c"}) {
+		t.Errorf("Expected tags [a b c], got %v", got)
+	}
+	if got := columnValues(out, "id"); !reflect.DeepEqual(got, []interface{}{1, 1, 3}) {
+		t.Errorf("Expected ids [1 1 3], got %v", got)
+	}
+	if out.head.RowNum() != 7 || out.head.next.RowNum() != 7 {
+		t.Errorf("Expected expanded rows to keep the row number, got %d", out.head.RowNum())
+	}
+
+	var empty *LinkedList
+	if empty.FlatMap(nil).Len() != 0 {
+		t.Error("Expected an empty list from a nil list")
+	}
+}
@@ -0,0 +1,132 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flatten replaces nested map[string]interface{} values with top-level keys
+// joined by sep, so {"addr": {"city": "NY"}} becomes {"addr.city": "NY"}
+// for sep ".". Empty nested maps are kept as they are. If two paths flatten
+// to the same key the list is left unchanged and an error is returned.
+func (ll *LinkedList) Flatten(sep string) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		flat := make(map[string]interface{}, len(n.Data))
+		if err := flattenInto(flat, "", sep, n.Data); err != nil {
+			return fmt.Errorf("error flattening row %d: %w", pos, err)
+		}
+		rows = append(rows, flat)
+	}
+
+	i := 0
+	for n := ll.head; n != nil; n = n.next {
+		if n.Data != nil {
+			n.Data, n.sharedData = rows[i], false
+		}
+		i++
+	}
+	return nil
+}
+
+// flattenInto copies data into flat, prefixing keys with prefix.
+func flattenInto(flat map[string]interface{}, prefix, sep string, data map[string]interface{}) error {
+	for k, v := range data {
+		key := k
+		if prefix != "" {
+			key = prefix + sep + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			if err := flattenInto(flat, key, sep, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, exists := flat[key]; exists {
+			return fmt.Errorf("duplicate key %q", key)
+		}
+		flat[key] = v
+	}
+	return nil
+}
+
+// Nest is the inverse of Flatten: keys containing sep are split into nested
+// maps. If a key is both a value and a parent of other keys the list is left
+// unchanged and an error is returned.
+func (ll *LinkedList) Nest(sep string) error {
+	if ll == nil {
+		return nil
+	}
+	if sep == "" {
+		return fmt.Errorf("separator must not be empty")
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	pos := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		pos++
+		nested, err := nestRow(n.Data, sep)
+		if err != nil {
+			return fmt.Errorf("error nesting row %d: %w", pos, err)
+		}
+		rows = append(rows, nested)
+	}
+
+	i := 0
+	for n := ll.head; n != nil; n = n.next {
+		if n.Data != nil {
+			n.Data, n.sharedData = rows[i], false
+		}
+		i++
+	}
+	return nil
+}
+
+// nestRow splits the keys of one row on sep.
+func nestRow(data map[string]interface{}, sep string) (map[string]interface{}, error) {
+	nested := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		parts := strings.Split(k, sep)
+		m := nested
+		for _, part := range parts[:len(parts)-1] {
+			child, exists := m[part]
+			if !exists {
+				next := make(map[string]interface{})
+				m[part] = next
+				m = next
+				continue
+			}
+			next, ok := child.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("key %q conflicts with a value at %q", k, part)
+			}
+			m = next
+		}
+		leaf := parts[len(parts)-1]
+		if existing, exists := m[leaf]; exists {
+			if _, isMap := existing.(map[string]interface{}); isMap {
+				return nil, fmt.Errorf("key %q conflicts with nested keys", k)
+			}
+		}
+		m[leaf] = v
+	}
+	return nested, nil
+}
@@ -0,0 +1,70 @@
+package linkedlist
+
+import "testing"
+
+func TestFlattenAndNest(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"id": 1,
+		"address": map[string]interface{}{
+			"city": "NY",
+			"geo":  map[string]interface{}{"lat": 40.7},
+		},
+		"empty": map[string]interface{}{},
+	})
+
+	if err := ll.Flatten("."); err != nil {
+		t.Fatalf("Flatten failed: %v", err)
+	}
+	flat := ll.First().Data
+	if flat["address.city"] != "NY" || flat["address.geo.lat"] != 40.7 || flat["id"] != 1 {
+		t.Errorf("Unexpected flattened row: %+v", flat)
+	}
+	if _, ok := flat["address"]; ok {
+		t.Error("Expected nested key to be removed")
+	}
+
+	if err := ll.Nest("."); err != nil {
+		t.Fatalf("Nest failed: %v", err)
+	}
+	addr, ok := ll.First().Data["address"].(map[string]interface{})
+	if !ok || addr["city"] != "NY" {
+		t.Fatalf("Unexpected nested row: %+v", ll.First().Data)
+	}
+	if geo, ok := addr["geo"].(map[string]interface{}); !ok || geo["lat"] != 40.7 {
+		t.Errorf("Unexpected geo: %+v", addr["geo"])
+	}
+}
+
+func TestFlatten_KeepsSharedSourceIntact(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"a": map[string]interface{}{"b": 1}})
+	derived := ll.Filter(func(*Node) bool { return true })
+
+	if err := derived.Flatten("_"); err != nil {
+		t.Fatalf("Flatten failed: %v", err)
+	}
+	if _, ok := ll.First().Data["a"]; !ok {
+		t.Error("Flatten of derived list changed the source")
+	}
+	if derived.First().Data["a_b"] != 1 {
+		t.Errorf("Unexpected derived row: %+v", derived.First().Data)
+	}
+}
+
+func TestFlattenAndNest_Conflicts(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"a.b": 1, "a": map[string]interface{}{"b": 2}})
+	if err := ll.Flatten("."); err == nil {
+		t.Error("Expected duplicate key error from Flatten")
+	}
+
+	ll = New()
+	ll.Append(map[string]interface{}{"a": 1, "a.b": 2})
+	if err := ll.Nest("."); err == nil {
+		t.Error("Expected conflict error from Nest")
+	}
+	if ll.First().Data["a"] != 1 {
+		t.Error("Expected row unchanged after failed Nest")
+	}
+}
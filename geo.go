@@ -0,0 +1,196 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrNotPoint is returned when a geometry value is not a point.
+var ErrNotPoint = errors.New("linkedlist: geometry is not a point")
+
+// Point is a two-dimensional geometry point with longitude as X and
+// latitude as Y, the axis order PostGIS uses.
+type Point struct {
+	Lng float64
+	Lat float64
+}
+
+// UnmarshalText parses WKT, EWKT or hex-encoded (E)WKB, so Point fields
+// scan PostGIS geometry columns directly.
+func (p *Point) UnmarshalText(b []byte) error {
+	pt, err := parsePoint(string(b))
+	if err != nil {
+		return err
+	}
+	*p = pt
+	return nil
+}
+
+// String returns the point as WKT.
+func (p Point) String() string {
+	return "POINT(" + strconv.FormatFloat(p.Lng, 'g', -1, 64) + " " + strconv.FormatFloat(p.Lat, 'g', -1, 64) + ")"
+}
+
+// LatLng returns the latitude and longitude of the point stored in column.
+// The value may be a Point, WKT or EWKT text, or WKB or EWKB as raw or
+// hex-encoded bytes, as PostGIS geometry columns are returned.
+func (n *Node) LatLng(column string) (lat, lng float64, err error) {
+	if n == nil {
+		return 0, 0, fmt.Errorf("column %s: node contains no data", column)
+	}
+	v, ok := n.Data[column]
+	if !ok || v == nil {
+		return 0, 0, fmt.Errorf("column %s: no geometry", column)
+	}
+	var p Point
+	switch x := v.(type) {
+	case Point:
+		p = x
+	case *Point:
+		p = *x
+	case []byte:
+		p, err = ParseWKB(x)
+	case string:
+		p, err = parsePoint(x)
+	default:
+		err = fmt.Errorf("unsupported geometry type %T", v)
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("column %s: %w", column, err)
+	}
+	return p.Lat, p.Lng, nil
+}
+
+// parsePoint parses text holding WKT, EWKT, hex-encoded WKB or raw WKB.
+func parsePoint(s string) (Point, error) {
+	if b, ok := geometryBytes(s); ok {
+		return ParseWKB(b)
+	}
+	return ParseWKT(s)
+}
+
+// geometryBytes returns the WKB in s, which is hex-encoded or, when it was
+// loaded from a binary column, the raw bytes. It reports false for WKT.
+func geometryBytes(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+	if s[0] <= 1 {
+		return []byte(s), true
+	}
+	if b, err := hex.DecodeString(strings.TrimPrefix(s, `\x`)); err == nil {
+		return b, true
+	}
+	return nil, false
+}
+
+// ParseWKT parses a POINT in well-known text, with an optional EWKT
+// SRID=n; prefix.
+func ParseWKT(s string) (Point, error) {
+	text := strings.TrimSpace(s)
+	if i := strings.Index(text, ";"); i >= 0 && strings.HasPrefix(strings.ToUpper(text), "SRID=") {
+		text = strings.TrimSpace(text[i+1:])
+	}
+	body, ok := strings.CutPrefix(strings.ToUpper(text), "POINT")
+	if !ok {
+		return Point{}, fmt.Errorf("%w: %q", ErrNotPoint, s)
+	}
+	body = strings.TrimSpace(body)
+	if !strings.HasPrefix(body, "(") || !strings.HasSuffix(body, ")") {
+		return Point{}, fmt.Errorf("invalid WKT %q", s)
+	}
+	coords := strings.Fields(body[1 : len(body)-1])
+	if len(coords) < 2 {
+		return Point{}, fmt.Errorf("invalid WKT %q", s)
+	}
+	x, err := strconv.ParseFloat(coords[0], 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid WKT %q: %w", s, err)
+	}
+	y, err := strconv.ParseFloat(coords[1], 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid WKT %q: %w", s, err)
+	}
+	return Point{Lng: x, Lat: y}, nil
+}
+
+// EWKB flags PostGIS sets in the geometry type.
+const (
+	ewkbZ    = 0x80000000
+	ewkbM    = 0x40000000
+	ewkbSRID = 0x20000000
+)
+
+// ParseWKB parses a point in well-known binary or PostGIS extended WKB.
+func ParseWKB(b []byte) (Point, error) {
+	if len(b) < 5 {
+		return Point{}, errors.New("WKB too short")
+	}
+	var order binary.ByteOrder = binary.BigEndian
+	if b[0] == 1 {
+		order = binary.LittleEndian
+	}
+	typ := order.Uint32(b[1:5])
+	b = b[5:]
+	if typ&ewkbSRID != 0 {
+		if len(b) < 4 {
+			return Point{}, errors.New("WKB too short")
+		}
+		b = b[4:]
+	}
+	// ISO WKB encodes Z and M as 1000s, e.g. 1001 for POINT Z
+	if (typ&^(ewkbZ|ewkbM|ewkbSRID))%1000 != 1 {
+		return Point{}, fmt.Errorf("%w: WKB type %d", ErrNotPoint, typ&^(ewkbZ|ewkbM|ewkbSRID))
+	}
+	if len(b) < 16 {
+		return Point{}, errors.New("WKB too short")
+	}
+	return Point{
+		Lng: math.Float64frombits(order.Uint64(b[0:8])),
+		Lat: math.Float64frombits(order.Uint64(b[8:16])),
+	}, nil
+}
+
+// GeometryConverter returns a converter passing the WKB of a geometry
+// column to decode, for use with RegisterConverter and geometry libraries:
+//
+//	RegisterConverter(reflect.TypeOf((*orb.Geometry)(nil)).Elem(),
+//		GeometryConverter(func(b []byte) (interface{}, error) { return wkb.Unmarshal(b) }))
+//
+// Hex-encoded and raw WKB are accepted, as well as WKT points.
+func GeometryConverter(decode func(wkb []byte) (interface{}, error)) ConverterFunc {
+	return func(v interface{}) (interface{}, error) {
+		var b []byte
+		switch x := v.(type) {
+		case []byte:
+			b = x
+		case string:
+			var ok bool
+			if b, ok = geometryBytes(x); !ok {
+				p, err := ParseWKT(x)
+				if err != nil {
+					return nil, err
+				}
+				b = pointWKB(p)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported geometry type %T", v)
+		}
+		return decode(b)
+	}
+}
+
+// pointWKB encodes p as little-endian WKB.
+func pointWKB(p Point) []byte {
+	b := make([]byte, 21)
+	b[0] = 1
+	binary.LittleEndian.PutUint32(b[1:5], 1)
+	binary.LittleEndian.PutUint64(b[5:13], math.Float64bits(p.Lng))
+	binary.LittleEndian.PutUint64(b[13:21], math.Float64bits(p.Lat))
+	return b
+}
@@ -0,0 +1,98 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Hex EWKB PostGIS returns for ST_SetSRID(ST_MakePoint(13.4, 52.52), 4326).
+const testEWKB = "0101000020E6100000CDCCCCCCCCCC2A40C3F5285C8F424A40"
+
+func TestLatLng(t *testing.T) {
+	cases := map[string]interface{}{
+		"wkt":     "POINT(13.4 52.52)",
+		"ewkt":    "SRID=4326;POINT(13.4 52.52)",
+		"ewkb":    testEWKB,
+		"wkb":     pointWKB(Point{Lng: 13.4, Lat: 52.52}),
+		"rawwkb":  string(pointWKB(Point{Lng: 13.4, Lat: 52.52})),
+		"point":   Point{Lng: 13.4, Lat: 52.52},
+		"pointer": &Point{Lng: 13.4, Lat: 52.52},
+	}
+	n := &Node{Data: map[string]interface{}{}}
+	for k, v := range cases {
+		n.Data[k] = v
+	}
+	for col := range cases {
+		lat, lng, err := n.LatLng(col)
+		if err != nil {
+			t.Errorf("LatLng(%s) failed: %v", col, err)
+			continue
+		}
+		if lat != 52.52 || lng != 13.4 {
+			t.Errorf("Expected 52.52,13.4 for %s, got %v,%v", col, lat, lng)
+		}
+	}
+}
+
+func TestLatLngNotPoint(t *testing.T) {
+	n := &Node{Data: map[string]interface{}{"g": "LINESTRING(0 0, 1 1)"}}
+	if _, _, err := n.LatLng("g"); !errors.Is(err, ErrNotPoint) {
+		t.Errorf("Expected ErrNotPoint, got %v", err)
+	}
+	if _, _, err := n.LatLng("missing"); err == nil {
+		t.Error("Expected error for missing column, got nil")
+	}
+}
+
+func TestScanPointField(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"location": testEWKB, "origin": "POINT(0 0)"})
+
+	var dest struct {
+		Location Point  `db:"location"`
+		Origin   *Point `db:"origin"`
+	}
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if dest.Location != (Point{Lng: 13.4, Lat: 52.52}) {
+		t.Errorf("Expected POINT(13.4 52.52), got %v", dest.Location)
+	}
+	if dest.Origin == nil || *dest.Origin != (Point{}) {
+		t.Errorf("Expected POINT(0 0), got %v", dest.Origin)
+	}
+}
+
+// testGeometry stands in for an interface such as orb.Geometry.
+type testGeometry interface{ Kind() string }
+
+type testGeoPoint [2]float64
+
+func (testGeoPoint) Kind() string { return "Point" }
+
+func TestGeometryConverter(t *testing.T) {
+	geomType := reflect.TypeOf((*testGeometry)(nil)).Elem()
+	ll := New(WithConverter(geomType, GeometryConverter(func(b []byte) (interface{}, error) {
+		p, err := ParseWKB(b)
+		if err != nil {
+			return nil, err
+		}
+		return testGeoPoint{p.Lng, p.Lat}, nil
+	})))
+	ll.Append(map[string]interface{}{"a": testEWKB, "b": "POINT(1 2)"})
+
+	var dest struct {
+		A testGeometry `db:"a"`
+		B testGeometry `db:"b"`
+	}
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if dest.A != (testGeoPoint{13.4, 52.52}) {
+		t.Errorf("Expected [13.4 52.52], got %v", dest.A)
+	}
+	if dest.B != (testGeoPoint{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", dest.B)
+	}
+}
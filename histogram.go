@@ -0,0 +1,108 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ValueCounts returns one row per distinct value of column, holding the
+// value under column and its number of rows under "count" (int64), most
+// frequent first. Values with equal counts keep their order of first
+// appearance; numbers are counted by value regardless of Go type, and
+// missing values count as NULL. It fails if no row has the column.
+func (ll *LinkedList) ValueCounts(column string) (*LinkedList, error) {
+	type bucket struct {
+		value interface{}
+		count int64
+	}
+	var order []*bucket
+	buckets := make(map[string]*bucket)
+	found := false
+	err := ll.each(func(n *Node) error {
+		v, ok := lookupColumn(n.Data, column)
+		found = found || ok
+		k := encodeKey([]interface{}{v})
+		b, ok := buckets[k]
+		if !ok {
+			b = &bucket{value: v}
+			buckets[k] = b
+			order = append(order, b)
+		}
+		b.count++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found && len(order) > 0 {
+		return nil, fmt.Errorf("column %s not found", column)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return order[i].count > order[j].count })
+	result := New()
+	for _, b := range order {
+		result.Append(map[string]interface{}{column: b.value, "count": b.count})
+	}
+	return result, nil
+}
+
+// Histogram counts the numeric values of column in bins equal-width bins
+// between their minimum and maximum, returning one row per bin with its
+// "lower" and "upper" bounds (float64) and "count" (int64). Bins include
+// their lower bound and the last bin also its upper bound. When all values
+// are equal the bins span the value ± 0.5. NULLs are skipped; other
+// non-numeric values are an error.
+func (ll *LinkedList) Histogram(column string, bins int) (*LinkedList, error) {
+	if bins < 1 {
+		return nil, errors.New("bins must be at least 1")
+	}
+	var values []float64
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		v, _ := lookupColumn(n.Data, column)
+		if v == nil {
+			return nil
+		}
+		f, ok := toFloat64(v)
+		if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("row %d: %s value %v is not a finite number", pos, column, v)
+		}
+		values = append(values, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := New()
+	if len(values) == 0 {
+		return result, nil
+	}
+	lo, hi := values[0], values[0]
+	for _, f := range values {
+		lo, hi = math.Min(lo, f), math.Max(hi, f)
+	}
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+	width := (hi - lo) / float64(bins)
+	counts := make([]int64, bins)
+	for _, f := range values {
+		i := int((f - lo) / width)
+		if i >= bins {
+			i = bins - 1
+		}
+		counts[i]++
+	}
+	for i, c := range counts {
+		upper := lo + float64(i+1)*width
+		if i == bins-1 {
+			upper = hi
+		}
+		result.Append(map[string]interface{}{"lower": lo + float64(i)*width, "upper": upper, "count": c})
+	}
+	return result, nil
+}
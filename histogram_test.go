@@ -0,0 +1,86 @@
+package linkedlist
+
+import "testing"
+
+func TestValueCounts(t *testing.T) {
+	ll := New()
+	for _, v := range []interface{}{"b", "a", "b", int64(1), 1, nil, "a", "b"} {
+		ll.Append(map[string]interface{}{"v": v})
+	}
+	ll.Append(map[string]interface{}{"other": 1})
+
+	counts, err := ll.ValueCounts("v")
+	if err != nil {
+		t.Fatalf("ValueCounts failed: %v", err)
+	}
+	want := []struct {
+		value interface{}
+		count int64
+	}{{"b", 3}, {"a", 2}, {int64(1), 2}, {nil, 2}}
+	if counts.Len() != len(want) {
+		t.Fatalf("Expected %d rows, got %d", len(want), counts.Len())
+	}
+	i := 0
+	for n := counts.First(); n != nil; n = n.next {
+		if n.Data["v"] != want[i].value || n.Data["count"] != want[i].count {
+			t.Errorf("Expected row %d to be %v x%d, got %v", i, want[i].value, want[i].count, n.Data)
+		}
+		i++
+	}
+
+	if _, err := ll.ValueCounts("missing"); err == nil {
+		t.Error("Expected error for missing column, got nil")
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	ll := New()
+	for _, v := range []interface{}{0, 1, 2.5, 5, 7.5, 10, nil} {
+		ll.Append(map[string]interface{}{"x": v})
+	}
+
+	h, err := ll.Histogram("x", 4)
+	if err != nil {
+		t.Fatalf("Histogram failed: %v", err)
+	}
+	wantCounts := []int64{2, 1, 1, 2}
+	wantLower := []float64{0, 2.5, 5, 7.5}
+	i := 0
+	for n := h.First(); n != nil; n = n.next {
+		if n.Data["count"] != wantCounts[i] || n.Data["lower"] != wantLower[i] {
+			t.Errorf("Expected bin %d lower %v count %d, got %v", i, wantLower[i], wantCounts[i], n.Data)
+		}
+		i++
+	}
+	if i != 4 {
+		t.Fatalf("Expected 4 bins, got %d", i)
+	}
+	if h.Last().Data["upper"] != 10.0 {
+		t.Errorf("Expected last upper 10, got %v", h.Last().Data["upper"])
+	}
+}
+
+func TestHistogramSingleValue(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"x": 3})
+	ll.Append(map[string]interface{}{"x": 3})
+
+	h, err := ll.Histogram("x", 1)
+	if err != nil {
+		t.Fatalf("Histogram failed: %v", err)
+	}
+	if d := h.First().Data; d["lower"] != 2.5 || d["upper"] != 3.5 || d["count"] != int64(2) {
+		t.Errorf("Expected [2.5, 3.5] x2, got %v", d)
+	}
+}
+
+func TestHistogramErrors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"x": "abc"})
+	if _, err := ll.Histogram("x", 3); err == nil {
+		t.Error("Expected error for non-numeric value, got nil")
+	}
+	if _, err := ll.Histogram("x", 0); err == nil {
+		t.Error("Expected error for zero bins, got nil")
+	}
+}
@@ -0,0 +1,73 @@
+package linkedlist
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// hllPrecision is the number of index bits of the HyperLogLog sketch. 2^14
+// registers give a standard error of about 0.8% in 16 KiB.
+const hllPrecision = 14
+
+// hyperLogLog is a HyperLogLog cardinality sketch.
+type hyperLogLog struct {
+	registers [1 << hllPrecision]uint8
+}
+
+// add records the hash of a value.
+func (h *hyperLogLog) add(hash uint64) {
+	idx := hash >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1)) + 1)
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// estimate returns the approximate number of distinct values added.
+func (h *hyperLogLog) estimate() uint64 {
+	const m = float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	est := alpha * m * m / sum
+	if est <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		est = m * math.Log(m/float64(zeros))
+	}
+	return uint64(est + 0.5)
+}
+
+// hashValue hashes a value so that numerically equal values collide, like
+// GroupBy keys.
+func hashValue(v interface{}) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(encodeKey([]interface{}{v})))
+	// FNV's high bits are poorly mixed; finish with the splitmix64 mixer.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// ApproxDistinct estimates the number of distinct non-NULL values in column
+// with a HyperLogLog sketch, using constant memory regardless of the list's
+// size. Expect an error of around 1%.
+func (ll *LinkedList) ApproxDistinct(column string) uint64 {
+	var h hyperLogLog
+	ll.each(func(n *Node) error {
+		if v := n.Data[column]; v != nil {
+			h.add(hashValue(v))
+		}
+		return nil
+	})
+	return h.estimate()
+}
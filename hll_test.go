@@ -0,0 +1,35 @@
+package linkedlist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestApproxDistinct_Accuracy(t *testing.T) {
+	ll := New()
+	for i := 0; i < 100000; i++ {
+		ll.Append(map[string]interface{}{"user": fmt.Sprintf("user-%d", i%50000)})
+	}
+	got := ll.ApproxDistinct("user")
+	if errRate := math.Abs(float64(got)-50000) / 50000; errRate > 0.03 {
+		t.Errorf("Expected about 50000 distinct users, got %d (%.1f%% off)", got, errRate*100)
+	}
+}
+
+func TestApproxDistinct_SmallAndNull(t *testing.T) {
+	ll := New()
+	for _, v := range []interface{}{1, int64(1), 1.0, 2, nil, "2"} {
+		ll.Append(map[string]interface{}{"v": v})
+	}
+	if got := ll.ApproxDistinct("v"); got != 3 {
+		t.Errorf("Expected 3 distinct values, got %d", got)
+	}
+	if got := ll.ApproxDistinct("missing"); got != 0 {
+		t.Errorf("Expected 0 for a missing column, got %d", got)
+	}
+	var nilList *LinkedList
+	if got := nilList.ApproxDistinct("v"); got != 0 {
+		t.Errorf("Expected 0 for nil list, got %d", got)
+	}
+}
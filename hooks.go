@@ -0,0 +1,142 @@
+package linkedlist
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Hooks are callbacks invoked as the list loads, appends and scans rows.
+// Any of them may be nil. They run synchronously on the calling goroutine,
+// so they should be cheap, e.g. incrementing a Prometheus counter.
+type Hooks struct {
+	// OnLoadRow is called for every row read by a Load method.
+	OnLoadRow func(data map[string]interface{})
+	// OnAppend is called for every node added to the list, loaded or not.
+	OnAppend func(n *Node)
+	// OnScan is called after each row is scanned by ScanFirst or ToSlice,
+	// with the scan error if it failed.
+	OnScan func(n *Node, err error)
+	// OnError is called with the name of the failing operation and its error.
+	OnError func(op string, err error)
+}
+
+// WithHooks installs instrumentation callbacks on the list.
+func WithHooks(h Hooks) Option {
+	return func(ll *LinkedList) {
+		ll.hooks = h
+	}
+}
+
+// Stats is a snapshot of the list's built-in counters.
+type Stats struct {
+	RowsLoaded     int64 // rows read by Load methods
+	Appends        int64 // nodes appended, loaded rows included
+	Scans          int64 // rows scanned by ScanFirst and ToSlice
+	ScanErrors     int64 // scans that failed
+	BytesEstimated int64 // rough size of all appended rows
+}
+
+// listStats holds the live counters. They are atomic so that a metrics
+// collector may call Stats while another goroutine uses the list.
+type listStats struct {
+	rowsLoaded     atomic.Int64
+	appends        atomic.Int64
+	scans          atomic.Int64
+	scanErrors     atomic.Int64
+	bytesEstimated atomic.Int64
+}
+
+// Stats returns the list's counters.
+func (ll *LinkedList) Stats() Stats {
+	if ll == nil {
+		return Stats{}
+	}
+	return Stats{
+		RowsLoaded:     ll.stats.rowsLoaded.Load(),
+		Appends:        ll.stats.appends.Load(),
+		Scans:          ll.stats.scans.Load(),
+		ScanErrors:     ll.stats.scanErrors.Load(),
+		BytesEstimated: ll.stats.bytesEstimated.Load(),
+	}
+}
+
+// noteLoadRow counts a loaded row and calls OnLoadRow.
+func (ll *LinkedList) noteLoadRow(data map[string]interface{}) {
+	ll.stats.rowsLoaded.Add(1)
+	if ll.hooks.OnLoadRow != nil {
+		ll.hooks.OnLoadRow(data)
+	}
+}
+
+// noteAppend counts an appended node and calls OnAppend.
+func (ll *LinkedList) noteAppend(n *Node) {
+	ll.stats.appends.Add(1)
+	ll.stats.bytesEstimated.Add(estimateSize(n.Data))
+	if ll.hooks.OnAppend != nil {
+		ll.hooks.OnAppend(n)
+	}
+}
+
+// noteScan counts a scan of n and calls OnScan, and OnError if it failed.
+func (ll *LinkedList) noteScan(n *Node, err error) {
+	if ll == nil {
+		return
+	}
+	ll.stats.scans.Add(1)
+	if err != nil {
+		ll.stats.scanErrors.Add(1)
+	}
+	if ll.hooks.OnScan != nil {
+		ll.hooks.OnScan(n, err)
+	}
+	if err != nil {
+		ll.noteError("scan", err)
+	}
+}
+
+// noteError calls OnError.
+func (ll *LinkedList) noteError(op string, err error) {
+	if ll != nil && ll.hooks.OnError != nil {
+		ll.hooks.OnError(op, err)
+	}
+}
+
+// estimateSize returns a rough size in bytes of a row: its keys plus the
+// payload of its values. It is meant for trends and limits, not accounting.
+func estimateSize(data map[string]interface{}) int64 {
+	var size int64
+	for k, v := range data {
+		size += int64(len(k)) + estimateValueSize(v)
+	}
+	return size
+}
+
+// estimateValueSize returns a rough size in bytes of a single value.
+func estimateValueSize(v interface{}) int64 {
+	switch x := v.(type) {
+	case nil:
+		return 0
+	case string:
+		return int64(len(x))
+	case []byte:
+		return int64(len(x))
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case int32, uint32, float32:
+		return 4
+	case time.Time:
+		return 24
+	case map[string]interface{}:
+		return estimateSize(x)
+	case []interface{}:
+		var size int64
+		for _, e := range x {
+			size += estimateValueSize(e)
+		}
+		return size
+	default:
+		return 8
+	}
+}
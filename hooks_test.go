@@ -0,0 +1,54 @@
+package linkedlist
+
+import (
+	"testing"
+)
+
+func TestHooks_CountersAndCallbacks(t *testing.T) {
+	var loaded, appended, scanned, failed int
+	var lastOp string
+	ll := New(WithHooks(Hooks{
+		OnLoadRow: func(map[string]interface{}) { loaded++ },
+		OnAppend:  func(*Node) { appended++ },
+		OnScan:    func(_ *Node, err error) { scanned++ },
+		OnError:   func(op string, err error) { failed++; lastOp = op },
+	}))
+	if err := ll.LoadFromSQLx(queryRows(t, []string{"name"}, []interface{}{"Alice"}, []interface{}{"Bob"})); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	ll.Append(map[string]interface{}{"name": "Carol", "age": "old"})
+
+	var people []struct{ Age int }
+	if err := ll.ToSlice(&people); err == nil {
+		t.Fatal("Expected ToSlice to fail on the text age")
+	}
+
+	if loaded != 2 || appended != 3 || scanned != 3 || failed != 1 || lastOp != "scan" {
+		t.Errorf("Unexpected callbacks: loaded %d, appended %d, scanned %d, failed %d (%s)", loaded, appended, scanned, failed, lastOp)
+	}
+
+	stats := ll.Stats()
+	if stats.RowsLoaded != 2 || stats.Appends != 3 || stats.Scans != 3 || stats.ScanErrors != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+	if want := int64(len("name")*3 + len("AliceBobCarol") + len("age") + len("old")); stats.BytesEstimated != want {
+		t.Errorf("Expected %d estimated bytes, got %d", want, stats.BytesEstimated)
+	}
+}
+
+func TestStats_WithoutHooks(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1})
+	var row struct{ ID int }
+	if err := ll.ScanFirst(&row); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if stats := ll.Stats(); stats.Appends != 1 || stats.Scans != 1 || stats.RowsLoaded != 0 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+
+	var nilList *LinkedList
+	if stats := nilList.Stats(); stats != (Stats{}) {
+		t.Errorf("Expected zero stats for nil list, got %+v", stats)
+	}
+}
@@ -0,0 +1,279 @@
+package linkedlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Pagination selects how LoadFromHTTPJSON finds the next page.
+type Pagination int
+
+const (
+	// NoPagination fetches a single response.
+	NoPagination Pagination = iota
+	// PagePagination increments a page number query parameter until a page
+	// has fewer items than the limit.
+	PagePagination
+	// CursorPagination passes the cursor found in each response to the next
+	// request until the response has no cursor.
+	CursorPagination
+	// LinkPagination follows the rel="next" URL of the Link header.
+	LinkPagination
+)
+
+// defaultMaxHTTPBody is the response size limit when MaxBodyBytes is unset.
+const defaultMaxHTTPBody = 32 << 20
+
+// HTTPJSONOptions configures LoadFromHTTPJSON.
+type HTTPJSONOptions struct {
+	Pagination Pagination
+	// ItemsPath is the dot-separated path of the item array in the response,
+	// such as "data" or "result.items". Empty means the body is the array.
+	ItemsPath string
+	// Header is added to every request, e.g. for authorization.
+	Header http.Header
+	// MaxPages stops loading after this many pages; 0 means no limit.
+	// Without a limit, a cursor or next link that repeats an earlier one is
+	// an error rather than an endless loop.
+	MaxPages int
+	// MaxBodyBytes caps the size of each response body (default 32 MiB).
+	MaxBodyBytes int64
+
+	// PageParam and LimitParam name the query parameters of PagePagination
+	// (defaults "page" and "limit"). Pages start at StartPage (default 1) and
+	// Limit, when positive, is sent as the page size.
+	PageParam  string
+	LimitParam string
+	StartPage  int
+	Limit      int
+
+	// CursorParam names the query parameter carrying the cursor (default
+	// "cursor") and CursorPath the dot-separated path of the next cursor in
+	// the response (default "next_cursor").
+	CursorParam string
+	CursorPath  string
+
+	// Source labels every loaded node, see Node.Source.
+	Source string
+}
+
+// LoadFromHTTPJSON fetches JSON objects from a REST endpoint, following its
+// pagination, and appends them to the list. Integral numbers are stored as
+// int64 and other numbers as float64. client may be nil to use
+// http.DefaultClient.
+func (ll *LinkedList) LoadFromHTTPJSON(ctx context.Context, client *http.Client, rawURL string, opts HTTPJSONOptions) error {
+	if ll == nil {
+		return ErrNilList
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	pageParam := defaultString(opts.PageParam, "page")
+	limitParam := defaultString(opts.LimitParam, "limit")
+	cursorParam := defaultString(opts.CursorParam, "cursor")
+	cursorPath := defaultString(opts.CursorPath, "next_cursor")
+	page := opts.StartPage
+	if page == 0 {
+		page = 1
+	}
+	maxBody := opts.MaxBodyBytes
+	if maxBody <= 0 {
+		maxBody = defaultMaxHTTPBody
+	}
+
+	next := rawURL
+	seen := make(map[string]bool)
+	loaded := 0
+	defer func() { ll.record("load", loaded, "LoadFromHTTPJSON %s", rawURL) }()
+	for pages := 1; next != ""; pages++ {
+		reqURL := next
+		if opts.Pagination == PagePagination {
+			params := url.Values{pageParam: {strconv.Itoa(page)}}
+			if opts.Limit > 0 {
+				params.Set(limitParam, strconv.Itoa(opts.Limit))
+			}
+			var err error
+			if reqURL, err = withQuery(rawURL, params); err != nil {
+				return err
+			}
+		}
+		if seen[reqURL] {
+			return fmt.Errorf("pagination loops back to %s", reqURL)
+		}
+		seen[reqURL] = true
+
+		body, header, err := fetchJSON(ctx, client, reqURL, opts.Header, maxBody)
+		if err != nil {
+			return err
+		}
+		raw, ok := lookupPath(body, opts.ItemsPath)
+		if !ok {
+			return fmt.Errorf("response from %s has no %q", reqURL, opts.ItemsPath)
+		}
+		items, ok := raw.([]interface{})
+		if !ok {
+			return fmt.Errorf("response from %s: expected an array of items, got %T", reqURL, raw)
+		}
+		for i, item := range items {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("response from %s: item %d is %T, not an object", reqURL, i, item)
+			}
+			ll.noteLoadRow(row)
+			node := &Node{Data: row, source: opts.Source}
+			if ll.clock != nil {
+				node.timestamp = ll.clock.Now()
+			}
+			ll.appendNode(node)
+			loaded++
+		}
+
+		next = ""
+		if opts.MaxPages > 0 && pages >= opts.MaxPages {
+			break
+		}
+		switch opts.Pagination {
+		case PagePagination:
+			if len(items) > 0 && (opts.Limit <= 0 || len(items) >= opts.Limit) {
+				page++
+				next = rawURL
+			}
+		case CursorPagination:
+			if cursor, ok := lookupPath(body, cursorPath); ok && cursor != nil && cursor != "" {
+				if next, err = withQuery(rawURL, url.Values{cursorParam: {fmt.Sprint(cursor)}}); err != nil {
+					return err
+				}
+			}
+		case LinkPagination:
+			if next, err = nextLink(reqURL, header.Values("Link")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchJSON GETs reqURL and decodes its JSON body, which must not be larger
+// than maxBody bytes.
+func fetchJSON(ctx context.Context, client *http.Client, reqURL string, header http.Header, maxBody int64) (interface{}, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, vs := range header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("GET %s: unexpected status %s", reqURL, resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxBody+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("GET %s: %w", reqURL, err)
+	}
+	if int64(len(raw)) > maxBody {
+		return nil, nil, fmt.Errorf("GET %s: response body exceeds %d bytes", reqURL, maxBody)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var body interface{}
+	if err := dec.Decode(&body); err != nil {
+		return nil, nil, fmt.Errorf("GET %s: invalid JSON: %w", reqURL, err)
+	}
+	if body, err = DecodeJSONNumbers(body); err != nil {
+		return nil, nil, fmt.Errorf("GET %s: %w", reqURL, err)
+	}
+	return body, resp.Header, nil
+}
+
+// lookupPath follows a dot-separated path of object keys. An empty path
+// returns v itself.
+func lookupPath(v interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return v, true
+	}
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// withQuery returns rawURL with params set in its query string.
+func withQuery(rawURL string, params url.Values) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	for k, vs := range params {
+		q[k] = vs
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// nextLink returns the rel="next" target of RFC 8288 Link header values,
+// resolved against base, or "" when there is none.
+func nextLink(base string, values []string) (string, error) {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				name, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(name, "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(val, `"`)) {
+					if strings.EqualFold(rel, "next") {
+						b, err := url.Parse(base)
+						if err != nil {
+							return "", err
+						}
+						ref, err := url.Parse(target[1 : len(target)-1])
+						if err != nil {
+							return "", err
+						}
+						return b.ResolveReference(ref).String(), nil
+					}
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// defaultString returns s, or def when s is empty.
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
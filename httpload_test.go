@@ -0,0 +1,147 @@
+package linkedlist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLoadFromHTTPJSON_PagePagination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		switch page {
+		case 1:
+			fmt.Fprint(w, `{"data": [{"id": 1}, {"id": 2}]}`)
+		case 2:
+			fmt.Fprint(w, `{"data": [{"id": 3, "score": 1.5}]}`)
+		default:
+			t.Errorf("Unexpected page %d", page)
+		}
+	}))
+	defer srv.Close()
+
+	ll := New()
+	err := ll.LoadFromHTTPJSON(context.Background(), srv.Client(), srv.URL+"/users", HTTPJSONOptions{
+		Pagination: PagePagination,
+		ItemsPath:  "data",
+		Limit:      2,
+		Header:     http.Header{"Authorization": {"Bearer t"}},
+	})
+	if err != nil {
+		t.Fatalf("LoadFromHTTPJSON failed: %v", err)
+	}
+	if ll.Len() != 3 || ll.First().Data["id"] != int64(1) || ll.Last().Data["score"] != 1.5 {
+		t.Errorf("Unexpected rows: len %d, first %v, last %v", ll.Len(), ll.First().Data, ll.Last().Data)
+	}
+}
+
+func TestLoadFromHTTPJSON_Cursor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{"items": [{"id": 1}], "meta": {"next": "abc"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": [{"id": 2}], "meta": {"next": null}}`)
+	}))
+	defer srv.Close()
+
+	ll := New()
+	err := ll.LoadFromHTTPJSON(context.Background(), nil, srv.URL, HTTPJSONOptions{
+		Pagination:  CursorPagination,
+		ItemsPath:   "items",
+		CursorParam: "after",
+		CursorPath:  "meta.next",
+	})
+	if err != nil {
+		t.Fatalf("LoadFromHTTPJSON failed: %v", err)
+	}
+	if ll.Len() != 2 || ll.Last().Data["id"] != int64(2) {
+		t.Errorf("Expected 2 rows, got %d", ll.Len())
+	}
+}
+
+func TestLoadFromHTTPJSON_LinkHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/a" {
+			w.Header().Set("Link", `</b>; rel="next", </a>; rel="first"`)
+			fmt.Fprint(w, `[{"id": 1}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 2}]`)
+	}))
+	defer srv.Close()
+
+	ll := New()
+	if err := ll.LoadFromHTTPJSON(context.Background(), nil, srv.URL+"/a", HTTPJSONOptions{Pagination: LinkPagination, Source: "api"}); err != nil {
+		t.Fatalf("LoadFromHTTPJSON failed: %v", err)
+	}
+	if ll.Len() != 2 || ll.Last().Source() != "api" {
+		t.Errorf("Expected 2 rows labelled api, got %d", ll.Len())
+	}
+}
+
+func TestLoadFromHTTPJSON_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/scalars":
+			fmt.Fprint(w, `[1, 2]`)
+		default:
+			fmt.Fprint(w, `{"data": {}}`)
+		}
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/missing", "/scalars", "/object"} {
+		opts := HTTPJSONOptions{}
+		if path == "/object" {
+			opts.ItemsPath = "data"
+		}
+		if err := New().LoadFromHTTPJSON(context.Background(), nil, srv.URL+path, opts); err == nil {
+			t.Errorf("Expected error for %s", path)
+		}
+	}
+}
+
+func TestLoadFromHTTPJSON_RepeatedCursor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `</self>; rel="next"`)
+		fmt.Fprint(w, `{"items": [{"id": 1}], "next_cursor": "same"}`)
+	}))
+	defer srv.Close()
+
+	for _, p := range []Pagination{CursorPagination, LinkPagination} {
+		err := New().LoadFromHTTPJSON(context.Background(), nil, srv.URL+"/self", HTTPJSONOptions{
+			Pagination: p,
+			ItemsPath:  "items",
+		})
+		if err == nil || !strings.Contains(err.Error(), "loops") {
+			t.Errorf("Pagination %d: expected a loop error, got %v", p, err)
+		}
+	}
+}
+
+func TestLoadFromHTTPJSON_MaxBodyBytes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	}))
+	defer srv.Close()
+
+	err := New().LoadFromHTTPJSON(context.Background(), nil, srv.URL, HTTPJSONOptions{MaxBodyBytes: 10})
+	if err == nil || !strings.Contains(err.Error(), "exceeds 10 bytes") {
+		t.Errorf("Expected a size error, got %v", err)
+	}
+	ll := New()
+	if err := ll.LoadFromHTTPJSON(context.Background(), nil, srv.URL, HTTPJSONOptions{MaxBodyBytes: 22}); err != nil || ll.Len() != 2 {
+		t.Errorf("Expected a body of exactly the limit to load, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+// Package httputil writes linked lists as paginated JSON API responses.
+package httputil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// PageOptions configures pagination and field selection. Zero values fall
+// back to the defaults documented on each field.
+type PageOptions struct {
+	// DefaultPageSize is used when the request has no page size (default 50).
+	DefaultPageSize int
+	// MaxPageSize caps the requested page size (default 1000).
+	MaxPageSize int
+	// PageParam names the 1-based page query parameter (default "page").
+	PageParam string
+	// SizeParam names the page size query parameter (default "page_size").
+	SizeParam string
+	// FieldsParam names the comma-separated field selection parameter
+	// (default "fields").
+	FieldsParam string
+}
+
+// Response is the JSON envelope written by WriteJSONResponse.
+type Response struct {
+	Data       []map[string]interface{} `json:"data"`
+	Total      int                      `json:"total"`
+	Page       int                      `json:"page"`
+	PageSize   int                      `json:"page_size"`
+	TotalPages int                      `json:"total_pages"`
+}
+
+// withDefaults fills in unset options.
+func (o PageOptions) withDefaults() PageOptions {
+	if o.DefaultPageSize <= 0 {
+		o.DefaultPageSize = 50
+	}
+	if o.MaxPageSize <= 0 {
+		o.MaxPageSize = 1000
+	}
+	if o.PageParam == "" {
+		o.PageParam = "page"
+	}
+	if o.SizeParam == "" {
+		o.SizeParam = "page_size"
+	}
+	if o.FieldsParam == "" {
+		o.FieldsParam = "fields"
+	}
+	return o
+}
+
+// WriteJSONResponse writes one page of ll as a JSON Response. The page, page
+// size and selected fields are read from the request's query parameters.
+// Invalid parameters result in a 400 response with a JSON error body.
+func WriteJSONResponse(w http.ResponseWriter, r *http.Request, ll *linkedlist.LinkedList, opts PageOptions) error {
+	opts = opts.withDefaults()
+	query := r.URL.Query()
+
+	page, err := intParam(query.Get(opts.PageParam), 1)
+	if err != nil || page < 1 {
+		return writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s parameter", opts.PageParam))
+	}
+	size, err := intParam(query.Get(opts.SizeParam), opts.DefaultPageSize)
+	if err != nil || size < 1 {
+		return writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s parameter", opts.SizeParam))
+	}
+	if size > opts.MaxPageSize {
+		size = opts.MaxPageSize
+	}
+
+	var fields []string
+	if f := query.Get(opts.FieldsParam); f != "" {
+		for _, name := range strings.Split(f, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				fields = append(fields, name)
+			}
+		}
+	}
+
+	nodes := ll.Nodes()
+	total := len(nodes)
+	// Compare before multiplying so huge page numbers cannot overflow.
+	start := total
+	if page-1 <= total/size {
+		start = min((page-1)*size, total)
+	}
+	end := total
+	if size < total-start {
+		end = start + size
+	}
+
+	resp := Response{
+		Data:       make([]map[string]interface{}, 0, end-start),
+		Total:      total,
+		Page:       page,
+		PageSize:   size,
+		TotalPages: (total + size - 1) / size,
+	}
+	for _, n := range nodes[start:end] {
+		resp.Data = append(resp.Data, selectFields(n, fields))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(resp)
+}
+
+// intParam parses a query parameter, returning def when it is empty.
+func intParam(s string, def int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// selectFields returns the node's data restricted to fields, or all data
+// when fields is empty.
+func selectFields(n *linkedlist.Node, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return n.Data
+	}
+	row := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		if v, ok := n.Data[f]; ok {
+			row[f] = v
+		}
+	}
+	return row
+}
+
+// writeError writes a JSON error body with the given status.
+func writeError(w http.ResponseWriter, status int, msg string) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,88 @@
+package httputil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+func newList(n int) *linkedlist.LinkedList {
+	ll := linkedlist.New()
+	for i := 1; i <= n; i++ {
+		ll.Append(map[string]interface{}{"id": i, "name": "user", "secret": "x"})
+	}
+	return ll
+}
+
+func TestWriteJSONResponse_Pagination(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users?page=2&page_size=2&fields=id,name", nil)
+	rec := httptest.NewRecorder()
+
+	if err := WriteJSONResponse(rec, req, newList(5), PageOptions{}); err != nil {
+		t.Fatalf("WriteJSONResponse failed: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Total != 5 || resp.Page != 2 || resp.PageSize != 2 || resp.TotalPages != 3 {
+		t.Errorf("Unexpected envelope: %+v", resp)
+	}
+	if len(resp.Data) != 2 || resp.Data[0]["id"] != float64(3) {
+		t.Fatalf("Unexpected page data: %+v", resp.Data)
+	}
+	if _, ok := resp.Data[0]["secret"]; ok {
+		t.Error("Expected unselected field to be omitted")
+	}
+}
+
+func TestWriteJSONResponse_DefaultsAndBounds(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users?page=9&page_size=5000", nil)
+	rec := httptest.NewRecorder()
+
+	if err := WriteJSONResponse(rec, req, newList(3), PageOptions{MaxPageSize: 10}); err != nil {
+		t.Fatalf("WriteJSONResponse failed: %v", err)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.PageSize != 10 || len(resp.Data) != 0 || resp.Total != 3 {
+		t.Errorf("Unexpected envelope: %+v", resp)
+	}
+}
+
+func TestWriteJSONResponse_HugePage(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users?page=4611686018427387904&page_size=4", nil)
+	rec := httptest.NewRecorder()
+
+	if err := WriteJSONResponse(rec, req, newList(3), PageOptions{}); err != nil {
+		t.Fatalf("WriteJSONResponse failed: %v", err)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Data) != 0 || resp.Total != 3 {
+		t.Errorf("Unexpected envelope: %+v", resp)
+	}
+}
+
+func TestWriteJSONResponse_InvalidParams(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users?page=abc", nil)
+	rec := httptest.NewRecorder()
+
+	if err := WriteJSONResponse(rec, req, newList(1), PageOptions{}); err != nil {
+		t.Fatalf("WriteJSONResponse failed: %v", err)
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,150 @@
+package linkedlist
+
+import "math/rand"
+
+const (
+	skipMaxLevel = 32
+	skipP        = 0.25
+)
+
+// skipNode is a tower in the skip list. Level 0 mirrors the Node.next chain.
+type skipNode struct {
+	node    *Node
+	forward []*skipNode
+}
+
+// IndexedList is a linked list kept in order by a less function. It is backed
+// by a skip list, giving O(log n) ordered inserts and range queries while
+// keeping the iteration and scanning API of LinkedList.
+type IndexedList struct {
+	list   *LinkedList
+	less   func(a, b *Node) bool
+	header *skipNode
+	level  int
+}
+
+// NewIndexed creates a new empty list ordered by less. Nodes comparing equal
+// keep their insertion order.
+func NewIndexed(less func(a, b *Node) bool) *IndexedList {
+	return &IndexedList{
+		list:   New(),
+		less:   less,
+		header: &skipNode{forward: make([]*skipNode, skipMaxLevel)},
+		level:  1,
+	}
+}
+
+// randomLevel picks the height of a new tower.
+func randomLevel() int {
+	lvl := 1
+	for lvl < skipMaxLevel && rand.Float64() < skipP {
+		lvl++
+	}
+	return lvl
+}
+
+// Append inserts a new row at its ordered position.
+func (il *IndexedList) Append(data map[string]interface{}) {
+	newNode := &Node{Data: data}
+
+	var update [skipMaxLevel]*skipNode
+	x := il.header
+	for i := il.level - 1; i >= 0; i-- {
+		for x.forward[i] != nil && !il.less(newNode, x.forward[i].node) {
+			x = x.forward[i]
+		}
+		update[i] = x
+	}
+
+	lvl := randomLevel()
+	if lvl > il.level {
+		for i := il.level; i < lvl; i++ {
+			update[i] = il.header
+		}
+		il.level = lvl
+	}
+
+	sn := &skipNode{node: newNode, forward: make([]*skipNode, lvl)}
+	for i := 0; i < lvl; i++ {
+		sn.forward[i] = update[i].forward[i]
+		update[i].forward[i] = sn
+	}
+
+	// Keep the underlying linked list in step with level 0.
+	ll := il.list
+	if sn.forward[0] != nil {
+		newNode.next = sn.forward[0].node
+		// Inserting before the tail may land behind a running iterator.
+		ll.mods++
+	} else {
+		ll.tail = newNode
+	}
+	if update[0] == il.header {
+		// An iterator that has not moved yet should start from the new head.
+		if ll.current == ll.head {
+			ll.current = newNode
+		}
+		ll.head = newNode
+	} else {
+		update[0].node.next = newNode
+	}
+	ll.len++
+}
+
+// seek returns the first tower whose node is not less than probe.
+func (il *IndexedList) seek(probe *Node) *skipNode {
+	x := il.header
+	for i := il.level - 1; i >= 0; i-- {
+		for x.forward[i] != nil && il.less(x.forward[i].node, probe) {
+			x = x.forward[i]
+		}
+	}
+	return x.forward[0]
+}
+
+// ByRange returns a new list with the rows ordered within [lo, hi).
+// The returned nodes share Data with the indexed list until either side
+// changes it through the package API.
+func (il *IndexedList) ByRange(lo, hi map[string]interface{}) *LinkedList {
+	result := New()
+	hiNode := &Node{Data: hi}
+	for x := il.seek(&Node{Data: lo}); x != nil && il.less(x.node, hiNode); x = x.forward[0] {
+		result.appendNode(x.node.shareCopy())
+	}
+	return result
+}
+
+// First returns the smallest node in the list.
+func (il *IndexedList) First() *Node {
+	return il.list.First()
+}
+
+// Last returns the largest node in the list.
+func (il *IndexedList) Last() *Node {
+	return il.list.Last()
+}
+
+// Next returns the next node in iteration.
+func (il *IndexedList) Next() *Node {
+	return il.list.Next()
+}
+
+// ResetIterator resets the iterator to the beginning.
+func (il *IndexedList) ResetIterator() {
+	il.list.ResetIterator()
+}
+
+// Err returns the error that stopped the last iteration with Next, if any.
+func (il *IndexedList) Err() error {
+	return il.list.Err()
+}
+
+// Len returns the length of the list.
+func (il *IndexedList) Len() int {
+	return il.list.Len()
+}
+
+// ToSlice scans all nodes, in order, into a slice of the given struct type.
+func (il *IndexedList) ToSlice(destSlice interface{}) error {
+	return il.list.ToSlice(destSlice)
+}
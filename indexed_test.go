@@ -0,0 +1,82 @@
+package linkedlist
+
+import "testing"
+
+func lessByID(a, b *Node) bool {
+	return a.Data["ID"].(int) < b.Data["ID"].(int)
+}
+
+func TestIndexed_AppendKeepsOrder(t *testing.T) {
+	il := NewIndexed(lessByID)
+	for _, id := range []int{5, 1, 4, 2, 3} {
+		il.Append(map[string]interface{}{"ID": id})
+	}
+	if il.Len() != 5 {
+		t.Errorf("Expected Len() to be 5, got %d", il.Len())
+	}
+	if il.First().Data["ID"] != 1 || il.Last().Data["ID"] != 5 {
+		t.Errorf("Unexpected bounds: first %v, last %v", il.First().Data, il.Last().Data)
+	}
+
+	il.ResetIterator()
+	var ids []int
+	for node := il.Next(); node != nil; node = il.Next() {
+		ids = append(ids, node.Data["ID"].(int))
+	}
+	for i, id := range ids {
+		if id != i+1 {
+			t.Fatalf("Expected ordered ids, got %v", ids)
+		}
+	}
+}
+
+func TestIndexed_StableForEqualKeys(t *testing.T) {
+	il := NewIndexed(lessByID)
+	il.Append(map[string]interface{}{"ID": 1, "Name": "first"})
+	il.Append(map[string]interface{}{"ID": 1, "Name": "second"})
+	il.Append(map[string]interface{}{"ID": 0, "Name": "zero"})
+
+	il.ResetIterator()
+	var names []string
+	for node := il.Next(); node != nil; node = il.Next() {
+		names = append(names, node.Data["Name"].(string))
+	}
+	if len(names) != 3 || names[0] != "zero" || names[1] != "first" || names[2] != "second" {
+		t.Errorf("Unexpected order: %v", names)
+	}
+}
+
+func TestIndexed_ByRange(t *testing.T) {
+	il := NewIndexed(lessByID)
+	for id := 10; id > 0; id-- {
+		il.Append(map[string]interface{}{"ID": id})
+	}
+
+	got := il.ByRange(map[string]interface{}{"ID": 3}, map[string]interface{}{"ID": 6})
+	if got.Len() != 3 {
+		t.Fatalf("Expected 3 rows in range, got %d", got.Len())
+	}
+	if got.First().Data["ID"] != 3 || got.Last().Data["ID"] != 5 {
+		t.Errorf("Unexpected range bounds: %v..%v", got.First().Data, got.Last().Data)
+	}
+
+	empty := il.ByRange(map[string]interface{}{"ID": 20}, map[string]interface{}{"ID": 30})
+	if empty.Len() != 0 {
+		t.Errorf("Expected empty range, got %d rows", empty.Len())
+	}
+}
+
+func TestIndexed_ToSlice(t *testing.T) {
+	type Item struct{ ID int }
+	il := NewIndexed(lessByID)
+	il.Append(map[string]interface{}{"ID": 2})
+	il.Append(map[string]interface{}{"ID": 1})
+
+	var items []Item
+	if err := il.ToSlice(&items); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != 1 || items[1].ID != 2 {
+		t.Errorf("Unexpected items: %+v", items)
+	}
+}
@@ -0,0 +1,181 @@
+package linkedlist
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect selects the SQL flavour of generated statements.
+type Dialect int
+
+const (
+	// Postgres quotes identifiers with "", writes TRUE/FALSE and bytea hex.
+	Postgres Dialect = iota
+	// MySQL quotes identifiers with backticks and escapes backslashes.
+	MySQL
+	// SQLite quotes identifiers with "" and writes blobs as X'..'.
+	SQLite
+	// SQLServer quotes identifiers with [] and writes blobs as 0x...
+	SQLServer
+)
+
+// String returns the dialect's name.
+func (d Dialect) String() string {
+	switch d {
+	case Postgres:
+		return "postgres"
+	case MySQL:
+		return "mysql"
+	case SQLite:
+		return "sqlite"
+	case SQLServer:
+		return "sqlserver"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// QuoteIdent quotes a possibly schema-qualified table name, quoting each
+// dot-separated part.
+func (d Dialect) QuoteIdent(name string) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = d.quoteName(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteName quotes a single identifier, such as a column name, whole: dots
+// in it are part of the name.
+func (d Dialect) quoteName(name string) string {
+	switch d {
+	case MySQL:
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	case SQLServer:
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// Literal formats v as an SQL literal. It supports nil, booleans, numbers,
+// strings, []byte and time.Time.
+func (d Dialect) Literal(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		if d == Postgres {
+			return strings.ToUpper(strconv.FormatBool(x)), nil
+		}
+		if x {
+			return "1", nil
+		}
+		return "0", nil
+	case string:
+		return d.quoteString(x), nil
+	case []byte:
+		switch d {
+		case Postgres:
+			return `'\x` + hex.EncodeToString(x) + `'`, nil
+		case SQLServer:
+			return "0x" + hex.EncodeToString(x), nil
+		}
+		return "X'" + hex.EncodeToString(x) + "'", nil
+	case time.Time:
+		switch d {
+		case Postgres:
+			return "'" + x.Format("2006-01-02 15:04:05.999999Z07:00") + "'", nil
+		case SQLServer:
+			return "'" + x.Format("2006-01-02T15:04:05.9999999") + "'", nil
+		}
+		return "'" + x.Format("2006-01-02 15:04:05.999999") + "'", nil
+	}
+	if i, ok := toInt64(v); ok {
+		return strconv.FormatInt(i, 10), nil
+	}
+	if s, ok := bigText(v); ok {
+		return s, nil
+	}
+	if f, ok := toFloat64(v); ok {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", fmt.Errorf("%v has no SQL literal", f)
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64), nil
+	}
+	return "", fmt.Errorf("unsupported value type %T", v)
+}
+
+// quoteString quotes a string literal, doubling quotes and, for MySQL,
+// escaping backslashes. SQL Server literals get the N prefix so characters
+// outside the database's code page survive.
+func (d Dialect) quoteString(s string) string {
+	switch d {
+	case MySQL:
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	case SQLServer:
+		return "N'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// GenerateInsertSQL returns a script of INSERT statements adding every row
+// to table, batchSize rows per statement (all rows in one statement when
+// batchSize is not positive). The columns are those of all rows in name
+// order; missing values are NULL.
+func (ll *LinkedList) GenerateInsertSQL(table string, dialect Dialect, batchSize int) (string, error) {
+	if table == "" {
+		return "", errors.New("table must not be empty")
+	}
+	if dialect < Postgres || dialect > SQLServer {
+		return "", fmt.Errorf("unknown dialect %v", dialect)
+	}
+	columns := ll.columnNames()
+	if len(columns) == 0 {
+		return "", nil
+	}
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = dialect.quoteName(c)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", dialect.QuoteIdent(table), strings.Join(quoted, ", "))
+
+	var sb strings.Builder
+	inBatch, pos := 0, 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		if inBatch == 0 {
+			sb.WriteString(prefix)
+		} else {
+			sb.WriteString(",\n")
+		}
+		sb.WriteString("  (")
+		for i, c := range columns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			lit, err := dialect.Literal(n.Data[c])
+			if err != nil {
+				return fmt.Errorf("row %d, column %s: %w", pos, c, err)
+			}
+			sb.WriteString(lit)
+		}
+		sb.WriteString(")")
+		inBatch++
+		if batchSize > 0 && inBatch == batchSize {
+			sb.WriteString(";\n")
+			inBatch = 0
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if inBatch > 0 {
+		sb.WriteString(";\n")
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,92 @@
+package linkedlist
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGenerateInsertSQL_Batches(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "O'Brien"})
+	ll.Append(map[string]interface{}{"id": 2, "name": nil})
+	ll.Append(map[string]interface{}{"id": 3, "active": true})
+
+	got, err := ll.GenerateInsertSQL("public.users", Postgres, 2)
+	if err != nil {
+		t.Fatalf("GenerateInsertSQL failed: %v", err)
+	}
+	want := `INSERT INTO "public"."users" ("active", "id", "name") VALUES
+  (NULL, 1, 'O''Brien'),
+  (NULL, 2, NULL);
+INSERT INTO "public"."users" ("active", "id", "name") VALUES
+  (TRUE, 3, NULL);
+`
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestDialect_Literals(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		dialect Dialect
+		value   interface{}
+		want    string
+	}{
+		{MySQL, `it's a \ path`, `'it''s a \\ path'`},
+		{Postgres, `it's a \ path`, `'it''s a \ path'`},
+		{MySQL, false, "0"},
+		{Postgres, []byte{0xca, 0xfe}, `'\xcafe'`},
+		{SQLite, []byte{0xca, 0xfe}, "X'cafe'"},
+		{SQLServer, []byte{0xca, 0xfe}, "0xcafe"},
+		{Postgres, at, "'2024-01-02 03:04:05Z'"},
+		{MySQL, at, "'2024-01-02 03:04:05'"},
+		{SQLite, 2.5, "2.5"},
+		{SQLServer, uint8(7), "7"},
+		{SQLServer, "Zoë's", "N'Zoë''s'"},
+	}
+	for _, tt := range tests {
+		got, err := tt.dialect.Literal(tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("%v.Literal(%v): expected %s, got %s (%v)", tt.dialect, tt.value, tt.want, got, err)
+		}
+	}
+
+	if got := MySQL.QuoteIdent("db.order`s"); got != "`db`.`order``s`" {
+		t.Errorf("Unexpected MySQL identifier: %s", got)
+	}
+	if got := SQLServer.QuoteIdent("dbo.a]b"); got != "[dbo].[a]]b]" {
+		t.Errorf("Unexpected SQL Server identifier: %s", got)
+	}
+}
+
+func TestGenerateInsertSQL_DottedColumn(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"geo.lat": 1.5})
+
+	got, err := ll.GenerateInsertSQL("s.t", SQLServer, 0)
+	if err != nil {
+		t.Fatalf("GenerateInsertSQL failed: %v", err)
+	}
+	want := "INSERT INTO [s].[t] ([geo.lat]) VALUES\n  (1.5);\n"
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestGenerateInsertSQL_Errors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"v": math.NaN()})
+	if _, err := ll.GenerateInsertSQL("t", SQLite, 0); err == nil {
+		t.Error("Expected error for NaN")
+	}
+	ll2 := New()
+	ll2.Append(map[string]interface{}{"v": []int{1}})
+	if _, err := ll2.GenerateInsertSQL("t", SQLite, 0); err == nil {
+		t.Error("Expected error for unsupported type")
+	}
+	if _, err := ll2.GenerateInsertSQL("t", Dialect(9), 0); err == nil {
+		t.Error("Expected error for unknown dialect")
+	}
+}
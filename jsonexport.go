@@ -0,0 +1,188 @@
+package linkedlist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// KeyOrder selects the order of keys in exported JSON objects.
+type KeyOrder int
+
+const (
+	// SortedKeys orders keys alphabetically.
+	SortedKeys KeyOrder = iota
+	// ColumnOrder orders keys as JSONOptions.Columns, or else as the
+	// columns were loaded from the database, with any other keys following
+	// alphabetically.
+	ColumnOrder
+)
+
+// JSONOptions configures WriteJSON.
+type JSONOptions struct {
+	KeyOrder KeyOrder
+	// Columns gives the key order for ColumnOrder.
+	Columns []string
+	// Indent, when set, pretty-prints with one row per object and Indent
+	// per nesting level.
+	Indent string
+}
+
+// WriteJSON writes the rows as a JSON array of objects whose output is the
+// same on every run: keys follow opts.KeyOrder (nested objects are always
+// sorted) and numbers use one canonical form, integers without a fraction
+// or exponent and floats in their shortest round-trip form, with -0 written
+// as 0. NaN and infinities are errors.
+func (ll *LinkedList) WriteJSON(w io.Writer, opts JSONOptions) error {
+	order := opts.Columns
+	if opts.KeyOrder == ColumnOrder && len(order) == 0 && ll != nil {
+		order = ll.columnOrder
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		if pos > 0 {
+			buf.WriteByte(',')
+		}
+		pos++
+		keys := rowKeys(n.Data, opts.KeyOrder, order)
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			name, _ := json.Marshal(k)
+			buf.Write(name)
+			buf.WriteByte(':')
+			v, err := canonicalJSON(n.Data[k])
+			if err != nil {
+				return fmt.Errorf("row %d, column %s: %w", pos, k, err)
+			}
+			buf.Write(v)
+		}
+		buf.WriteByte('}')
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	buf.WriteByte(']')
+
+	bw := bufio.NewWriter(w)
+	if opts.Indent != "" {
+		var out bytes.Buffer
+		if err := json.Indent(&out, buf.Bytes(), "", opts.Indent); err != nil {
+			return err
+		}
+		out.WriteTo(bw)
+	} else {
+		buf.WriteTo(bw)
+	}
+	bw.WriteByte('\n')
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	ll.record("export", pos, "WriteJSON")
+	return nil
+}
+
+// rowKeys returns the keys of a row in export order.
+func rowKeys(data map[string]interface{}, order KeyOrder, columns []string) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if order != ColumnOrder {
+		return keys
+	}
+	rank := func(k string) int {
+		if i := slices.Index(columns, k); i >= 0 {
+			return i
+		}
+		return len(columns)
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return rank(keys[i]) < rank(keys[j]) })
+	return keys
+}
+
+// canonicalJSON encodes a value with canonical numbers and sorted keys.
+func canonicalJSON(v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case float64:
+		return canonicalFloat(x)
+	case float32:
+		return canonicalFloat(float64(x))
+	case *big.Int, big.Int, *big.Float, big.Float, *big.Rat, big.Rat:
+		if s, ok := bigText(x); ok {
+			return []byte(s), nil
+		}
+		if reflect.ValueOf(x).IsZero() {
+			return []byte("null"), nil
+		}
+		return nil, fmt.Errorf("%v cannot be encoded as JSON", x)
+	case map[string]interface{}:
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i, k := range rowKeys(x, SortedKeys, nil) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			name, _ := json.Marshal(k)
+			buf.Write(name)
+			buf.WriteByte(':')
+			e, err := canonicalJSON(x[k])
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(e)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	case []interface{}:
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i, e := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			b, err := canonicalJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(b)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	}
+	return json.Marshal(v)
+}
+
+// canonicalFloat encodes f in the shortest form that reads back as f.
+func canonicalFloat(f float64) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("%v cannot be encoded as JSON", f)
+	}
+	if f == 0 {
+		return []byte("0"), nil
+	}
+	return json.Marshal(f)
+}
+
+// noteColumns records loaded column names in order of first appearance.
+func (ll *LinkedList) noteColumns(cols []string) {
+	for _, c := range cols {
+		if !slices.Contains(ll.columnOrder, c) {
+			ll.columnOrder = append(ll.columnOrder, c)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestWriteJSON_SortedAndCanonical(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"z": 1, "a": 2.0, "m": math.Copysign(0, -1), "n": map[string]interface{}{"y": []interface{}{math.Copysign(0, -1), 1}, "b": 0.5}})
+	ll.Append(map[string]interface{}{"b": 1e21, "a": int64(-3), "s": "x"})
+
+	var first bytes.Buffer
+	if err := ll.WriteJSON(&first, JSONOptions{}); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	want := `[{"a":2,"m":0,"n":{"b":0.5,"y":[0,1]},"z":1},{"a":-3,"b":1e+21,"s":"x"}]` + "\n"
+	if first.String() != want {
+		t.Errorf("Expected %s, got %s", want, first.String())
+	}
+	for i := 0; i < 10; i++ {
+		var again bytes.Buffer
+		ll.WriteJSON(&again, JSONOptions{})
+		if again.String() != first.String() {
+			t.Fatal("Expected identical output on every run")
+		}
+	}
+}
+
+func TestWriteJSON_ColumnOrder(t *testing.T) {
+	ll := New()
+	if err := ll.LoadFromSQLx(queryRows(t, []string{"id", "name", "age"}, []interface{}{1, "Alice", 34})); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	ll.First().Set("extra", true)
+
+	var buf bytes.Buffer
+	if err := ll.WriteJSON(&buf, JSONOptions{KeyOrder: ColumnOrder}); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	if want := `[{"id":1,"name":"Alice","age":34,"extra":true}]` + "\n"; buf.String() != want {
+		t.Errorf("Expected %s, got %s", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := ll.WriteJSON(&buf, JSONOptions{KeyOrder: ColumnOrder, Columns: []string{"name"}, Indent: " "}); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	want := "[\n {\n  \"name\": \"Alice\",\n  \"age\": 34,\n  \"extra\": true,\n  \"id\": 1\n }\n]\n"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestWriteJSON_Errors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"v": math.Inf(1)})
+	if err := ll.WriteJSON(&bytes.Buffer{}, JSONOptions{}); err == nil {
+		t.Error("Expected error for infinity")
+	}
+	var buf bytes.Buffer
+	var nilList *LinkedList
+	if err := nilList.WriteJSON(&buf, JSONOptions{}); err != nil || buf.String() != "[]\n" {
+		t.Errorf("Expected empty array for nil list, got %q (%v)", buf.String(), err)
+	}
+}
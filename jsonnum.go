@@ -0,0 +1,41 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeJSONNumbers converts the json.Number values in v, as decoded with
+// json.Decoder.UseNumber, the way SQL drivers return numbers: integral ones
+// to int64 and others to float64. Objects and arrays are converted
+// recursively and in place. A number beyond the float64 range is an error.
+func DecodeJSONNumbers(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := x.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("number %s is out of range", x)
+		}
+		return f, nil
+	case map[string]interface{}:
+		for k, e := range x {
+			c, err := DecodeJSONNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = c
+		}
+	case []interface{}:
+		for i, e := range x {
+			c, err := DecodeJSONNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = c
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,34 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeJSONNumbers(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(`{"i": 7, "f": 1.5, "a": [1, {"n": -2}], "s": "3"}`)))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeJSONNumbers(v)
+	if err != nil {
+		t.Fatalf("DecodeJSONNumbers failed: %v", err)
+	}
+	want := map[string]interface{}{
+		"i": int64(7),
+		"f": 1.5,
+		"a": []interface{}{int64(1), map[string]interface{}{"n": int64(-2)}},
+		"s": "3",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if _, err := DecodeJSONNumbers([]interface{}{json.Number("1e400")}); err == nil {
+		t.Error("Expected error for a number out of range")
+	}
+}
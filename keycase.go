@@ -0,0 +1,74 @@
+package linkedlist
+
+import (
+	"strings"
+	"unicode"
+)
+
+// KeyCase selects how loaded column names are written as Data keys.
+type KeyCase int
+
+const (
+	// AsIs keeps the names the database reports.
+	AsIs KeyCase = iota
+	// Lower lower-cases names.
+	Lower
+	// Snake writes names as lower snake_case, so "UserID" and "User Id"
+	// become "user_id".
+	Snake
+	// Camel writes names as lower camelCase, so "user_id" becomes "userId".
+	Camel
+)
+
+// apply returns name in case c.
+func (c KeyCase) apply(name string) string {
+	switch c {
+	case Lower:
+		return strings.ToLower(name)
+	case Snake:
+		return strings.ToLower(strings.Join(splitWords(name), "_"))
+	case Camel:
+		words := splitWords(name)
+		for i, w := range words {
+			w = strings.ToLower(w)
+			if i > 0 {
+				r := []rune(w)
+				r[0] = unicode.ToUpper(r[0])
+				w = string(r)
+			}
+			words[i] = w
+		}
+		return strings.Join(words, "")
+	}
+	return name
+}
+
+// splitWords splits a column name at separators and case changes, keeping
+// acronyms together: "HTTPStatus_code2" gives HTTP, Status, code2.
+func splitWords(name string) []string {
+	var words []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = cur[:0]
+		}
+	}
+	rs := []rune(name)
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(cur) > 0 {
+			prev := cur[len(cur)-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,55 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestKeyCaseApply(t *testing.T) {
+	tests := []struct {
+		name                string
+		lower, snake, camel string
+	}{
+		{"UserID", "userid", "user_id", "userId"},
+		{"first_name", "first_name", "first_name", "firstName"},
+		{"HTTPStatus", "httpstatus", "http_status", "httpStatus"},
+		{"Order Total", "order total", "order_total", "orderTotal"},
+		{"address2Line", "address2line", "address2_line", "address2Line"},
+		{"id", "id", "id", "id"},
+	}
+	for _, tt := range tests {
+		if got := Lower.apply(tt.name); got != tt.lower {
+			t.Errorf("Lower(%q): expected %q, got %q", tt.name, tt.lower, got)
+		}
+		if got := Snake.apply(tt.name); got != tt.snake {
+			t.Errorf("Snake(%q): expected %q, got %q", tt.name, tt.snake, got)
+		}
+		if got := Camel.apply(tt.name); got != tt.camel {
+			t.Errorf("Camel(%q): expected %q, got %q", tt.name, tt.camel, got)
+		}
+		if got := AsIs.apply(tt.name); got != tt.name {
+			t.Errorf("AsIs(%q): expected it unchanged, got %q", tt.name, got)
+		}
+	}
+}
+
+func TestLoadKeyCase(t *testing.T) {
+	rows := queryRows(t, []string{"UserID", "FirstName"}, []interface{}{int64(1), "Ann"})
+	ll := New()
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{KeyCase: Snake}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := map[string]interface{}{"user_id": int64(1), "first_name": "Ann"}
+	if !reflect.DeepEqual(ll.head.Data, want) {
+		t.Errorf("Expected %v, got %v", want, ll.head.Data)
+	}
+	if !reflect.DeepEqual(ll.columnOrder, []string{"user_id", "first_name"}) {
+		t.Errorf("Expected column order in the new case, got %v", ll.columnOrder)
+	}
+
+	rows = queryRows(t, []string{"ID", "id"}, []interface{}{int64(1), int64(2)})
+	if err := New().LoadFromSQLxWithOptions(rows, LoadOptions{KeyCase: Lower, DuplicateColumns: DuplicateError}); !errors.Is(err, ErrDuplicateColumn) {
+		t.Errorf("Expected names equal after casing to be duplicates, got %v", err)
+	}
+}
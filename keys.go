@@ -0,0 +1,123 @@
+package linkedlist
+
+import (
+	"errors"
+	"maps"
+)
+
+// EncodeKey encodes values into a string usable as a map key for a
+// composite key. Numbers are encoded by value, so int(1) and int64(1) give
+// the same key, and times by instant.
+func EncodeKey(values ...interface{}) string {
+	return encodeKey(values)
+}
+
+// Key returns the encoded key of the node's columns, see EncodeKey.
+func (n *Node) Key(columns ...string) string {
+	return rowKey(n.Data, columns)
+}
+
+// Distinct returns a new list with the first row for each key, in order.
+// The key is made of columns, or of every column when none are given. The
+// result shares Data with ll until either side changes it.
+func (ll *LinkedList) Distinct(columns ...string) *LinkedList {
+	seen := make(map[string]bool)
+	return ll.Filter(func(n *Node) bool {
+		k := rowKey(n.Data, columns)
+		if seen[k] {
+			return false
+		}
+		seen[k] = true
+		return true
+	})
+}
+
+// Join returns the inner join of left and right on equal values of the key
+// columns: one row per matching pair, in left order and then right order,
+// holding the columns of both rows. Columns present on both sides keep the
+// left value. Rows with a NULL key column match nothing, as in SQL.
+func Join(left, right *LinkedList, keyColumns ...string) (*LinkedList, error) {
+	if len(keyColumns) == 0 {
+		return nil, errors.New("join needs at least one key column")
+	}
+	index := make(map[string][]*Node)
+	err := right.each(func(n *Node) error {
+		if !hasNullKey(n.Data, keyColumns) {
+			k := rowKey(n.Data, keyColumns)
+			index[k] = append(index[k], n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := New()
+	err = left.each(func(n *Node) error {
+		if hasNullKey(n.Data, keyColumns) {
+			return nil
+		}
+		for _, m := range index[rowKey(n.Data, keyColumns)] {
+			row := maps.Clone(m.Data)
+			if row == nil {
+				row = make(map[string]interface{}, len(n.Data))
+			}
+			maps.Copy(row, n.Data)
+			result.appendNode(n.derive(row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Upsert replaces the first row whose key columns equal those of data with
+// data, or appends data when there is none. If the matching row is spilled
+// it cannot be replaced and ErrSpilled is returned.
+func (ll *LinkedList) Upsert(data map[string]interface{}, keyColumns ...string) error {
+	if ll == nil {
+		return ErrNilList
+	}
+	if len(keyColumns) == 0 {
+		return errors.New("upsert needs at least one key column")
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+
+	k := rowKey(data, keyColumns)
+	// Spilled rows cannot be replaced, but a key among them must not be
+	// appended a second time either.
+	err := ll.spill.forEach(func(n *Node) error {
+		if rowKey(n.Data, keyColumns) == k {
+			return ErrSpilled
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for n := ll.front(); n != nil; n = n.succ() {
+		if rowKey(n.Data, keyColumns) == k {
+			n.Data = data
+			n.sharedData = false
+			ll.record("update", 1, "Upsert")
+			return nil
+		}
+	}
+	ll.Append(data)
+	ll.record("update", 1, "Upsert")
+	return nil
+}
+
+// hasNullKey reports whether any key column of a row is NULL or missing.
+func hasNullKey(data map[string]interface{}, keyColumns []string) bool {
+	for _, c := range keyColumns {
+		if data[c] == nil {
+			return true
+		}
+	}
+	return false
+}
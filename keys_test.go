@@ -0,0 +1,127 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func tenantRows() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"tenant_id": 1, "id": 1, "name": "a"})
+	ll.Append(map[string]interface{}{"tenant_id": 2, "id": 1, "name": "b"})
+	ll.Append(map[string]interface{}{"tenant_id": int64(1), "id": int64(1), "name": "c"})
+	ll.Append(map[string]interface{}{"tenant_id": 1, "id": 2, "name": "d"})
+	return ll
+}
+
+func TestEncodeKey(t *testing.T) {
+	if EncodeKey(1, "x") != EncodeKey(int64(1), "x") {
+		t.Error("Expected numbers to encode by value")
+	}
+	if EncodeKey("a\x00sb", "c") == EncodeKey("a", "b\x00sc") {
+		t.Error("Expected parts containing NUL to keep keys distinct")
+	}
+	if EncodeKey(1, 2) == EncodeKey(2, 1) {
+		t.Error("Expected key order to matter")
+	}
+	n := &Node{Data: map[string]interface{}{"tenant_id": 3, "id": 4}}
+	if n.Key("tenant_id", "id") != EncodeKey(3, 4) {
+		t.Errorf("Expected Node.Key to match EncodeKey, got %q", n.Key("tenant_id", "id"))
+	}
+}
+
+func TestDistinctComposite(t *testing.T) {
+	got := tenantRows().Distinct("tenant_id", "id")
+	if names := columnValues(got, "name"); !reflect.DeepEqual(names, []interface{}{"a", "b", "d"}) {
+		t.Errorf("Expected [a b d], got %v", names)
+	}
+	if tenantRows().Distinct().Len() != 4 {
+		t.Error("Expected whole-row distinct to keep every row")
+	}
+}
+
+func TestJoinComposite(t *testing.T) {
+	orders := New()
+	orders.Append(map[string]interface{}{"tenant_id": 1, "id": 2, "total": 10})
+	orders.Append(map[string]interface{}{"tenant_id": 2, "id": 1, "total": 20})
+	orders.Append(map[string]interface{}{"tenant_id": 3, "id": 1, "total": 30})
+	orders.Append(map[string]interface{}{"tenant_id": nil, "id": 1, "total": 40})
+
+	joined, err := Join(orders, tenantRows(), "tenant_id", "id")
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if got := columnValues(joined, "name"); !reflect.DeepEqual(got, []interface{}{"d", "b"}) {
+		t.Errorf("Expected names [d b], got %v", got)
+	}
+	if got := columnValues(joined, "total"); !reflect.DeepEqual(got, []interface{}{10, 20}) {
+		t.Errorf("Expected totals [10 20], got %v", got)
+	}
+
+	joined, err = Join(tenantRows(), tenantRows(), "tenant_id", "id")
+	if err != nil || joined.Len() != 6 {
+		t.Errorf("Expected 6 rows joining duplicate keys, got %d, %v", joined.Len(), err)
+	}
+	if _, err := Join(orders, orders); err == nil {
+		t.Error("Expected error without key columns")
+	}
+}
+
+func TestUpsertComposite(t *testing.T) {
+	ll := tenantRows()
+	if err := ll.Upsert(map[string]interface{}{"tenant_id": 2, "id": int64(1), "name": "B"}, "tenant_id", "id"); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+	if err := ll.Upsert(map[string]interface{}{"tenant_id": 2, "id": 2, "name": "e"}, "tenant_id", "id"); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+	if got := columnValues(ll, "name"); !reflect.DeepEqual(got, []interface{}{"a", "B", "c", "d", "e"}) {
+		t.Errorf("Expected [a B c d e], got %v", got)
+	}
+	if err := ll.Freeze().Upsert(map[string]interface{}{}, "id"); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestApplyChangesOnComposite(t *testing.T) {
+	ll := tenantRows()
+	changes := New()
+	changes.Append(map[string]interface{}{"op": "d", "tenant_id": 2, "id": 1})
+	changes.Append(map[string]interface{}{"op": "u", "tenant_id": 1, "id": 2, "name": "D"})
+	if err := ll.ApplyChangesOn(changes, []string{"tenant_id", "id"}, "op"); err != nil {
+		t.Fatalf("ApplyChangesOn failed: %v", err)
+	}
+	if got := columnValues(ll, "name"); !reflect.DeepEqual(got, []interface{}{"a", "c", "D"}) {
+		t.Errorf("Expected [a c D], got %v", got)
+	}
+}
+
+func TestConcurrentIndexComposite(t *testing.T) {
+	ci := tenantRows().ConcurrentIndex("tenant_id", "id")
+	if n, ok := ci.Get(int64(2), 1); !ok || n.Data["name"] != "b" {
+		t.Errorf("Expected b for (2, 1), got %v", n)
+	}
+	if rows := ci.GetAll(1, 1); len(rows) != 2 {
+		t.Errorf("Expected 2 rows for (1, 1), got %d", len(rows))
+	}
+	if ci.Len() != 3 {
+		t.Errorf("Expected 3 distinct keys, got %d", ci.Len())
+	}
+}
+
+func TestUpsertSpilled(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 1))
+	defer ll.Close()
+	ll.Append(map[string]interface{}{"id": 1})
+	ll.Append(map[string]interface{}{"id": 2})
+
+	if err := ll.Upsert(map[string]interface{}{"id": 1, "v": "x"}, "id"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled for a spilled key, got %v", err)
+	}
+	if err := ll.Upsert(map[string]interface{}{"id": 3}, "id"); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+	if ll.Len() != 3 {
+		t.Errorf("Expected 3 rows, got %d", ll.Len())
+	}
+}
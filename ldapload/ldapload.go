@@ -0,0 +1,91 @@
+// Package ldapload turns LDAP search results into linked list rows.
+//
+// Entries are passed as the package's own Entry and Attribute values, which
+// carry the DN, names and string values of github.com/go-ldap/ldap's Entry
+// and EntryAttribute. A search result converts with a short loop:
+//
+//	entries := make([]ldapload.Entry, len(res.Entries))
+//	for i, e := range res.Entries {
+//		entries[i].DN = e.DN
+//		for _, a := range e.Attributes {
+//			entries[i].Attributes = append(entries[i].Attributes,
+//				ldapload.Attribute{Name: a.Name, Values: a.Values})
+//		}
+//	}
+//	err := ldapload.Load(ll, entries, ldapload.Options{})
+package ldapload
+
+import (
+	"strings"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// Entry is a directory entry returned by a search.
+type Entry struct {
+	DN         string
+	Attributes []Attribute
+}
+
+// Attribute is a named, possibly multi-valued, entry attribute.
+type Attribute struct {
+	Name   string
+	Values []string
+}
+
+// Options controls how entries become rows.
+type Options struct {
+	// DNKey is the Data key holding the entry's DN (default "dn").
+	DNKey string
+	// MultiValued names attributes that are always stored as []string, even
+	// with a single value, such as "memberOf". Other attributes are stored
+	// as a string when they have one value and as []string otherwise.
+	// Names match case-insensitively, as in LDAP.
+	MultiValued []string
+	// LowerCaseNames stores attribute names in lower case, so that
+	// "mail" and "Mail" from different servers map to the same key.
+	LowerCaseNames bool
+}
+
+// Load appends one row per entry to ll. Each attribute becomes a Data key;
+// attributes without values are stored as nil.
+func Load(ll *linkedlist.LinkedList, entries []Entry, opts Options) error {
+	if ll == nil {
+		return linkedlist.ErrNilList
+	}
+	dnKey := opts.DNKey
+	if dnKey == "" {
+		dnKey = "dn"
+	}
+
+	for _, e := range entries {
+		row := make(map[string]interface{}, len(e.Attributes)+1)
+		row[dnKey] = e.DN
+		for _, a := range e.Attributes {
+			name := a.Name
+			if opts.LowerCaseNames {
+				name = strings.ToLower(name)
+			}
+			switch {
+			case len(a.Values) == 0:
+				row[name] = nil
+			case len(a.Values) == 1 && !isMultiValued(a.Name, opts.MultiValued):
+				row[name] = a.Values[0]
+			default:
+				row[name] = append([]string(nil), a.Values...)
+			}
+		}
+		ll.Append(row)
+	}
+	return nil
+}
+
+// isMultiValued reports whether name is listed in multi, ignoring case.
+func isMultiValued(name string, multi []string) bool {
+	for _, m := range multi {
+		if strings.EqualFold(name, m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package ldapload
+
+import (
+	"reflect"
+	"testing"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+func TestLoad(t *testing.T) {
+	entries := []Entry{
+		{DN: "uid=alice,ou=people,dc=example,dc=com", Attributes: []Attribute{
+			{Name: "uid", Values: []string{"alice"}},
+			{Name: "Mail", Values: []string{"alice@example.com", "a@example.com"}},
+			{Name: "memberOf", Values: []string{"cn=admins"}},
+		}},
+		{DN: "uid=bob,ou=people,dc=example,dc=com", Attributes: []Attribute{
+			{Name: "uid", Values: []string{"bob"}},
+			{Name: "mail"},
+		}},
+	}
+
+	ll := linkedlist.New()
+	if err := Load(ll, entries, Options{MultiValued: []string{"memberof"}, LowerCaseNames: true}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", ll.Len())
+	}
+
+	alice := ll.First().Data
+	if alice["dn"] != entries[0].DN || alice["uid"] != "alice" {
+		t.Errorf("Unexpected row: %v", alice)
+	}
+	if !reflect.DeepEqual(alice["mail"], []string{"alice@example.com", "a@example.com"}) {
+		t.Errorf("Expected multi-valued mail slice, got %v", alice["mail"])
+	}
+	if !reflect.DeepEqual(alice["memberof"], []string{"cn=admins"}) {
+		t.Errorf("Expected memberOf as a slice, got %#v", alice["memberof"])
+	}
+	if v, ok := ll.Last().Data["mail"]; !ok || v != nil {
+		t.Errorf("Expected nil mail for bob, got %v", v)
+	}
+
+	type user struct {
+		UID  string   `db:"uid"`
+		Mail []string `db:"mail"`
+	}
+	var u user
+	if err := ll.ScanFirst(&u); err != nil || len(u.Mail) != 2 {
+		t.Errorf("Expected scan into []string, got %+v, %v", u, err)
+	}
+}
+
+func TestLoad_NilList(t *testing.T) {
+	if err := Load(nil, nil, Options{}); err != linkedlist.ErrNilList {
+		t.Errorf("Expected ErrNilList, got %v", err)
+	}
+}
@@ -2,38 +2,103 @@
 package linkedlist
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"runtime/debug"
 	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// ErrNoRows is returned by ScanFirst when the list is empty. It matches
+// sql.ErrNoRows with errors.Is.
+var ErrNoRows = fmt.Errorf("linkedlist: %w", sql.ErrNoRows)
+
+// ErrConcurrentModification is reported by Err when the list was structurally
+// changed while being iterated with Next.
+var ErrConcurrentModification = errors.New("linkedlist: list modified during iteration")
+
+// ErrNilList is returned by methods that cannot operate on a nil *LinkedList.
+var ErrNilList = errors.New("linkedlist: nil list")
+
 // Node represents a single node in the linked list containing data.
 type Node struct {
 	Data map[string]interface{}
 	next *Node
+
+	timestamp time.Time
+	meta      map[string]interface{}
+	rowNum    int
+	source    string
+	packed    []byte
+
+	sharedData bool
+	frozen     bool // belongs to a view returned by Freeze
+	deleted    bool
 }
 
 // LinkedList represents a linked list of data with scanning capabilities.
+// A nil *LinkedList behaves as an empty list: readers return zero values and
+// mutators are no-ops or return ErrNilList.
 type LinkedList struct {
 	head    *Node
 	tail    *Node
 	current *Node // for iteration
 	len     int
+
+	maxLen  int
+	onEvict func(*Node)
+	clock   Clock
+	meta    map[string]interface{}
+	spill   *spillStore
+
+	frozen bool
+
+	mods     int // structural modification counter
+	iterMods int // value of mods when the iterator was reset
+	iterErr  error
+	debug    bool
+
+	auditOn bool
+	audit   []AuditEntry
+
+	hooks  Hooks
+	stats  listStats
+	tracer Tracer
+	logger *slog.Logger
+
+	columnOrder  []string // column names in the order they were loaded
+	converters   map[reflect.Type]ConverterFunc
+	assumeLoc    *time.Location // see AssumeZone
+	convertLoc   *time.Location // see ConvertTo
+	lenientBools bool           // see WithLenientBools
+
+	budget *loadBudget // set while a load with MaxBytes runs
 }
 
-// New creates a new empty linked list.
-func New() *LinkedList {
-	return &LinkedList{}
+// New creates a new empty linked list configured by the given options.
+func New(opts ...Option) *LinkedList {
+	ll := &LinkedList{}
+	for _, opt := range opts {
+		opt(ll)
+	}
+	return ll
 }
 
 // StructScan scans the current node's data into the provided struct.
 // The destination must be a pointer to a struct. Supports db and json struct tags.
 func (n *Node) StructScan(dest interface{}) error {
-	if n.Data == nil {
+	return n.structScan(dest, nil)
+}
+
+// structScan is StructScan using the logger and converters of s, which may
+// be nil.
+func (n *Node) structScan(dest interface{}, s *scanner) error {
+	if n == nil || n.Data == nil {
 		return errors.New("node contains no data")
 	}
 
@@ -47,6 +112,12 @@ func (n *Node) StructScan(dest interface{}) error {
 		return errors.New("destination must be a pointer to a struct")
 	}
 
+	return s.scanMap(n.Data, destElem)
+}
+
+// scanMap sets the fields of the struct value destElem from data. Fields left
+// unset are reported to the scanner's logger at debug level.
+func (s *scanner) scanMap(data map[string]interface{}, destElem reflect.Value) error {
 	destType := destElem.Type()
 
 	for i := 0; i < destType.NumField(); i++ {
@@ -57,42 +128,36 @@ func (n *Node) StructScan(dest interface{}) error {
 			continue
 		}
 
-		// Get the field name, considering db and json tags
-		fieldName := field.Name
-		if tag := field.Tag.Get("db"); tag != "" {
-			fieldName = tag
-		} else if tag := field.Tag.Get("json"); tag != "" {
-			if commaIdx := strings.Index(tag, ","); commaIdx != -1 {
-				fieldName = tag[:commaIdx]
-			} else {
-				fieldName = tag
-			}
-		}
+		// Get the field name, considering db, protobuf and json tags
+		fieldName := fieldColumnName(field)
 
 		// Try case-insensitive match if exact match not found
 		var dataValue interface{}
 		var found bool
-		if dataValue, found = n.Data[fieldName]; !found {
+		if dataValue, found = data[fieldName]; !found {
 			// Case-insensitive search
-			for k, v := range n.Data {
+			for k, v := range data {
 				if strings.EqualFold(k, fieldName) {
 					dataValue = v
 					found = true
+					s.debug("linkedlist: matched column ignoring case", "field", field.Name, "column", k)
 					break
 				}
 			}
 			if !found {
+				s.debug("linkedlist: no column for field", "field", field.Name, "column", fieldName)
 				continue
 			}
 		}
 
 		// Handle NULL values
 		if dataValue == nil {
+			s.debug("linkedlist: skipped NULL column", "field", field.Name, "column", fieldName)
 			continue
 		}
 
 		// Convert the data value to the field type
-		if err := setFieldValue(fieldValue, field.Type, dataValue); err != nil {
+		if err := s.setStructField(fieldValue, field, dataValue); err != nil {
 			return fmt.Errorf("error setting field %s: %w", fieldName, err)
 		}
 	}
@@ -100,32 +165,162 @@ func (n *Node) StructScan(dest interface{}) error {
 	return nil
 }
 
-// setFieldValue handles the actual value conversion and assignment
+// fieldColumnName returns the column a struct field maps to: its db tag, the
+// name option of a protobuf tag, its json tag, or else the field name.
+func fieldColumnName(field reflect.StructField) string {
+	if tag := field.Tag.Get("db"); tag != "" {
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name
+		}
+	}
+	if tag := field.Tag.Get("protobuf"); tag != "" {
+		for _, opt := range strings.Split(tag, ",") {
+			if name, ok := strings.CutPrefix(opt, "name="); ok {
+				return name
+			}
+		}
+	}
+	if tag := field.Tag.Get("json"); tag != "" {
+		if commaIdx := strings.Index(tag, ","); commaIdx != -1 {
+			return tag[:commaIdx]
+		}
+		return tag
+	}
+	return field.Name
+}
+
+// ScanPanicError is returned when converting a value panicked inside the
+// reflect package. It carries the types involved and the stack at the panic.
+type ScanPanicError struct {
+	ValueType reflect.Type
+	FieldType reflect.Type
+	Recovered interface{}
+	Stack     []byte
+}
+
+func (e *ScanPanicError) Error() string {
+	return fmt.Sprintf("panic converting %v to %v: %v", e.ValueType, e.FieldType, e.Recovered)
+}
+
+// setFieldValue converts and assigns dataValue to field without a logger or
+// custom converters.
 func setFieldValue(field reflect.Value, fieldType reflect.Type, dataValue interface{}) error {
+	return (*scanner)(nil).setFieldValue(field, fieldType, dataValue)
+}
+
+// setFieldValue handles the actual value conversion and assignment. Panics
+// raised by reflection are returned as a *ScanPanicError.
+func (s *scanner) setFieldValue(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &ScanPanicError{
+				ValueType: reflect.TypeOf(dataValue),
+				FieldType: fieldType,
+				Recovered: r,
+				Stack:     debug.Stack(),
+			}
+		}
+	}()
+
+	if !field.CanSet() {
+		return fmt.Errorf("field of type %v is not settable", fieldType)
+	}
+
+	// Registered converters take precedence over generic conversion
+	if handled, err := s.convert(field, fieldType, dataValue); handled {
+		return err
+	}
+
 	// Special handling for time.Time
 	if fieldType == reflect.TypeOf(time.Time{}) {
-		if t, ok := dataValue.(time.Time); ok {
+		if t, ok := s.scanTime(dataValue); ok {
 			field.Set(reflect.ValueOf(t))
 			return nil
 		}
-		if s, ok := dataValue.(string); ok {
-			if t, err := time.Parse(time.RFC3339, s); err == nil {
-				field.Set(reflect.ValueOf(t))
-				return nil
-			}
-		}
 	}
 
 	dataVal := reflect.ValueOf(dataValue)
 	if !dataVal.IsValid() {
 		return nil
 	}
+	// A typed nil pointer is a NULL value
+	if dataVal.Kind() == reflect.Ptr && dataVal.IsNil() {
+		return nil
+	}
+
+	if handled, err := s.setLenientBool(field, fieldType, dataValue); handled {
+		return err
+	}
+
+	if handled, err := setUUIDArray(field, fieldType, dataValue); handled {
+		return err
+	}
+
+	// Big numbers are parsed exactly rather than through float64
+	if handled, err := setBigNumber(field, fieldType, dataValue); handled {
+		return err
+	}
+
+	// Interface fields (interface{}, any, or named interfaces) receive the raw
+	// value unchanged
+	if fieldType.Kind() == reflect.Interface {
+		if !dataVal.Type().Implements(fieldType) {
+			return fmt.Errorf("%T does not implement %v", dataValue, fieldType)
+		}
+		field.Set(dataVal)
+		return nil
+	}
+
+	// Postgres array literals and parsed arrays fill slices element-wise
+	if fieldType.Kind() == reflect.Slice {
+		elems, ok, err := arrayForSlice(fieldType, dataValue)
+		if err != nil {
+			return err
+		}
+		if ok {
+			dataVal = reflect.ValueOf(elems)
+		}
+	}
 
 	if dataVal.Type().ConvertibleTo(fieldType) {
 		field.Set(dataVal.Convert(fieldType))
 		return nil
 	}
 
+	// Handle nested rows such as pre-parsed JSON objects
+	if nested, ok := dataValue.(map[string]interface{}); ok {
+		if handled, err := s.setFromMap(field, fieldType, nested); handled {
+			return err
+		}
+	}
+
+	// Handle slices element by element, e.g. grouped child rows into []Child
+	if fieldType.Kind() == reflect.Slice && dataVal.Kind() == reflect.Slice {
+		newSlice := reflect.MakeSlice(fieldType, dataVal.Len(), dataVal.Len())
+		for i := 0; i < dataVal.Len(); i++ {
+			elem := dataVal.Index(i).Interface()
+			if elem == nil {
+				continue
+			}
+			if err := s.setFieldValue(newSlice.Index(i), fieldType.Elem(), elem); err != nil {
+				return fmt.Errorf("error setting element %d: %w", i, err)
+			}
+		}
+		field.Set(newSlice)
+		return nil
+	}
+
+	// Handle protobuf well-known wrapper messages such as *wrapperspb.StringValue
+	if isWrapperType(fieldType) {
+		wrapper := reflect.New(fieldType.Elem())
+		valueField, _ := fieldType.Elem().FieldByName("Value")
+		if err := s.setFieldValue(wrapper.Elem().FieldByIndex(valueField.Index), valueField.Type, dataValue); err != nil {
+			return err
+		}
+		field.Set(wrapper)
+		return nil
+	}
+
 	if fieldType.Kind() == reflect.Ptr {
 		// Handle pointer fields
 		if dataVal.Kind() == reflect.Ptr {
@@ -145,20 +340,73 @@ func setFieldValue(field reflect.Value, fieldType reflect.Type, dataValue interf
 		}
 	}
 
+	// Handle text-decoded types such as decimal.Decimal
+	if handled, err := unmarshalText(field, fieldType, dataValue); handled {
+		return err
+	}
+
 	return fmt.Errorf("cannot convert %T to %v", dataValue, fieldType)
 }
 
-// LoadFromSQLx loads data from sqlx rows into the linked list.
-func (ll *LinkedList) LoadFromSQLx(rows *sqlx.Rows) error {
-	for rows.Next() {
-		rowData, err := scanRowToMap(rows)
-		if err != nil {
-			return err
+// setFromMap scans a nested row into a struct, struct pointer or
+// string-keyed map field. It reports false if the field is none of these.
+func (s *scanner) setFromMap(field reflect.Value, fieldType reflect.Type, nested map[string]interface{}) (bool, error) {
+	switch {
+	case fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}):
+		newVal := reflect.New(fieldType).Elem()
+		if err := s.scanMap(nested, newVal); err != nil {
+			return true, err
+		}
+		field.Set(newVal)
+		return true, nil
+	case fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct:
+		newVal := reflect.New(fieldType.Elem())
+		if err := s.scanMap(nested, newVal.Elem()); err != nil {
+			return true, err
 		}
-		ll.Append(rowData)
+		field.Set(newVal)
+		return true, nil
+	case fieldType.Kind() == reflect.Map && fieldType.Key().Kind() == reflect.String:
+		newMap := reflect.MakeMapWithSize(fieldType, len(nested))
+		elemType := fieldType.Elem()
+		for k, v := range nested {
+			elem := reflect.New(elemType).Elem()
+			if v != nil {
+				if err := s.setFieldValue(elem, elemType, v); err != nil {
+					return true, fmt.Errorf("error setting key %s: %w", k, err)
+				}
+			}
+			newMap.SetMapIndex(reflect.ValueOf(k).Convert(fieldType.Key()), elem)
+		}
+		field.Set(newMap)
+		return true, nil
 	}
+	return false, nil
+}
 
-	return rows.Err()
+// isWrapperType reports whether t looks like a protobuf wrapper message
+// pointer: a pointer to a struct named *Value with a scalar Value field.
+func isWrapperType(t reflect.Type) bool {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	if !strings.HasSuffix(t.Elem().Name(), "Value") {
+		return false
+	}
+	f, ok := t.Elem().FieldByName("Value")
+	if !ok || !f.IsExported() {
+		return false
+	}
+	switch f.Type.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Ptr, reflect.Interface:
+		return false
+	}
+	return true
+}
+
+// LoadFromSQLx loads data from sqlx rows into the linked list.
+func (ll *LinkedList) LoadFromSQLx(rows *sqlx.Rows) error {
+	return ll.LoadFromSQLxWithOptions(rows, LoadOptions{})
 }
 
 // scanRowToMap scans a single row into a map[string]interface{}
@@ -167,7 +415,12 @@ func scanRowToMap(rows *sqlx.Rows) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get columns: %w", err)
 	}
+	return scanRowAs(rows, cols)
+}
 
+// scanRowAs scans a single row into a map keyed by cols, which name the
+// result columns in order.
+func scanRowAs(rows *sqlx.Rows, cols []string) (map[string]interface{}, error) {
 	values := make([]interface{}, len(cols))
 	for i := range values {
 		var v interface{}
@@ -191,10 +444,22 @@ func scanRowToMap(rows *sqlx.Rows) (map[string]interface{}, error) {
 	return rowData, nil
 }
 
-// Append adds a new row to the end of the list.
+// Append adds a new row to the end of the list. Append on a nil list is a
+// no-op and on a frozen list panics with ErrFrozen.
 func (ll *LinkedList) Append(data map[string]interface{}) {
+	if ll == nil {
+		return
+	}
 	newNode := &Node{Data: data}
+	if ll.clock != nil {
+		newNode.timestamp = ll.clock.Now()
+	}
+	ll.appendNode(newNode)
+}
 
+// appendNode links newNode at the tail, evicting from the head when bounded.
+func (ll *LinkedList) appendNode(newNode *Node) {
+	ll.mustMutate()
 	if ll.head == nil {
 		ll.head = newNode
 		ll.tail = newNode
@@ -204,39 +469,141 @@ func (ll *LinkedList) Append(data map[string]interface{}) {
 		ll.tail = newNode
 	}
 	ll.len++
+	ll.noteAppend(newNode)
+
+	if ll.maxLen > 0 && ll.len > ll.maxLen {
+		evicted := ll.head
+		ll.unlink(nil, evicted)
+		ll.mods++
+		ll.budget.evict(evicted)
+		if ll.onEvict != nil {
+			evicted.expand()
+			ll.onEvict(evicted)
+		}
+	}
+
+	if ll.spill != nil && ll.len > ll.spill.maxInMemory {
+		ll.spillHead()
+	}
 }
 
-// First returns the first node in the list.
+// unlink removes n from the list given its predecessor (nil when n is the head).
+func (ll *LinkedList) unlink(prev, n *Node) {
+	if prev == nil {
+		ll.head = n.next
+	} else {
+		prev.next = n.next
+	}
+	if ll.tail == n {
+		ll.tail = prev
+	}
+	if ll.current == n {
+		ll.current = n.next
+	}
+	n.next = nil
+	ll.len--
+}
+
+// First returns the first node in the list. If that row is spilled, the
+// node is a read-only copy, see WithSpill.
 func (ll *LinkedList) First() *Node {
-	return ll.head
+	if ll == nil {
+		return nil
+	}
+	if ll.spill.spilled() > 0 {
+		return ll.spill.first()
+	}
+	return ll.front()
 }
 
 // Last returns the last node in the list.
 func (ll *LinkedList) Last() *Node {
+	if ll == nil {
+		return nil
+	}
+	ll.tail.expand()
 	return ll.tail
 }
 
-// Next returns the next node in iteration.
+// IsEmpty reports whether the list has no nodes.
+func (ll *LinkedList) IsEmpty() bool {
+	if ll == nil {
+		return true
+	}
+	return ll.Len() == 0
+}
+
+// ScanFirst scans the first node into dest, like sqlx's Get. It returns
+// ErrNoRows when the list is empty.
+func (ll *LinkedList) ScanFirst(dest interface{}) error {
+	first := ll.First()
+	if first == nil {
+		return ErrNoRows
+	}
+	err := first.structScan(dest, ll.scanner())
+	ll.noteScan(first, err)
+	return err
+}
+
+// Next returns the next node in iteration. If the list was structurally
+// changed since the last ResetIterator, by removing or reordering nodes,
+// Next returns nil and Err reports ErrConcurrentModification; in debug mode
+// it panics instead. Appending does not invalidate the iterator.
 func (ll *LinkedList) Next() *Node {
+	if ll == nil || ll.iterErr != nil {
+		return nil
+	}
+	if ll.iterMods != ll.mods {
+		if ll.debug {
+			panic(ErrConcurrentModification)
+		}
+		ll.iterErr = ErrConcurrentModification
+		return nil
+	}
+	if n := ll.spill.next(); n != nil {
+		return n
+	}
 	if ll.current == nil {
 		return nil
 	}
 	current := ll.current
+	if err := current.expand(); err != nil {
+		ll.iterErr = err
+		return nil
+	}
 	ll.current = ll.current.next
 	return current
 }
 
 // ResetIterator resets the iterator to the beginning.
 func (ll *LinkedList) ResetIterator() {
+	if ll == nil {
+		return
+	}
+	ll.spill.rewind()
 	ll.current = ll.head
+	ll.iterMods = ll.mods
+	ll.iterErr = nil
+}
+
+// Err returns the error that stopped the last iteration with Next, if any.
+func (ll *LinkedList) Err() error {
+	if ll == nil {
+		return nil
+	}
+	return ll.iterErr
 }
 
 // Len returns the length of the list.
 func (ll *LinkedList) Len() int {
-	return ll.len
+	if ll == nil {
+		return 0
+	}
+	return ll.len + ll.spill.spilled()
 }
 
-// ToSlice scans all nodes into a slice of the given struct type.
+// ToSlice scans all nodes into a slice of the given struct type. It does not
+// move the list's iterator.
 func (ll *LinkedList) ToSlice(destSlice interface{}) error {
 	sliceVal := reflect.ValueOf(destSlice)
 	if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
@@ -246,14 +613,41 @@ func (ll *LinkedList) ToSlice(destSlice interface{}) error {
 	sliceElem := sliceVal.Elem()
 	elementType := sliceElem.Type().Elem()
 
-	ll.ResetIterator()
-	for node := ll.Next(); node != nil; node = ll.Next() {
+	rows := 0
+	err := ll.each(func(node *Node) error {
 		newElement := reflect.New(elementType)
-		if err := node.StructScan(newElement.Interface()); err != nil {
+		err := node.structScan(newElement.Interface(), ll.scanner())
+		ll.noteScan(node, err)
+		if err != nil {
 			return err
 		}
 		sliceElem.Set(reflect.Append(sliceElem, newElement.Elem()))
+		rows++
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	ll.record("export", rows, "ToSlice %s", elementType)
+	return nil
+}
 
+// each calls fn for every node, spilled rows included, without touching the
+// list's iterator. It stops at the first error fn returns.
+func (ll *LinkedList) each(fn func(*Node) error) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.spill.forEach(fn); err != nil {
+		return err
+	}
+	for n := ll.head; n != nil; n = n.next {
+		if err := n.expand(); err != nil {
+			return err
+		}
+		if err := fn(n); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -1,6 +1,9 @@
 package linkedlist
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -345,6 +348,44 @@ func TestLen(t *testing.T) {
 		t.Errorf("Expected Len() to be 2, got %d", ll.Len())
 	}
 }
+func TestIsEmpty(t *testing.T) {
+	ll := New()
+	if !ll.IsEmpty() {
+		t.Error("Expected new list to be empty")
+	}
+	ll.Append(map[string]interface{}{"ID": 1})
+	if ll.IsEmpty() {
+		t.Error("Expected list with one node to not be empty")
+	}
+}
+
+func TestScanFirst(t *testing.T) {
+	type User struct{ ID int }
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.Append(map[string]interface{}{"ID": 2})
+
+	var u User
+	if err := ll.ScanFirst(&u); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if u.ID != 1 {
+		t.Errorf("Expected ID to be 1, got %d", u.ID)
+	}
+}
+
+func TestScanFirst_EmptyList(t *testing.T) {
+	type User struct{ ID int }
+	var u User
+	err := New().ScanFirst(&u)
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Expected error matching sql.ErrNoRows, got %v", err)
+	}
+	if !errors.Is(err, ErrNoRows) {
+		t.Errorf("Expected error matching ErrNoRows, got %v", err)
+	}
+}
+
 func TestToSlice_Basic(t *testing.T) {
 	type User struct {
 		ID   int
@@ -771,3 +812,312 @@ func TestScanRowToMap_ScanError(t *testing.T) {
 		t.Error("Expected error from scanRowToMap due to scan error, got nil")
 	}
 }
+
+func TestNilList_SafeMethods(t *testing.T) {
+	type User struct{ ID int }
+	var ll *LinkedList
+
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.AppendAt(map[string]interface{}{"ID": 1}, time.Now())
+	ll.ResetIterator()
+	ll.SetMeta("k", "v")
+
+	if ll.Len() != 0 || !ll.IsEmpty() {
+		t.Error("Expected nil list to report empty")
+	}
+	if ll.First() != nil || ll.Last() != nil || ll.Next() != nil {
+		t.Error("Expected nil nodes from nil list")
+	}
+	if _, ok := ll.Meta("k"); ok {
+		t.Error("Expected no metadata on nil list")
+	}
+	if n := ll.ExpireOlderThan(time.Second, SystemClock{}); n != 0 {
+		t.Errorf("Expected 0 expired nodes, got %d", n)
+	}
+
+	var u User
+	if err := ll.ScanFirst(&u); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Expected ErrNoRows from nil list, got %v", err)
+	}
+	var users []User
+	if err := ll.ToSlice(&users); err != nil || len(users) != 0 {
+		t.Errorf("Expected empty slice from nil list, got %v (err=%v)", users, err)
+	}
+	if err := ll.ToSlice(users); err == nil {
+		t.Error("Expected destination validation error from nil list")
+	}
+	if err := ll.LoadFromSQLx(nil); !errors.Is(err, ErrNilList) {
+		t.Errorf("Expected ErrNilList from LoadFromSQLx, got %v", err)
+	}
+}
+
+// StringValue and Int64Value mirror the shape of wrapperspb messages.
+type StringValue struct {
+	state int
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+type Int64Value struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func TestStructScan_ProtobufTags(t *testing.T) {
+	type UserReply struct {
+		state    int
+		UserId   int64        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+		Nickname *StringValue `protobuf:"bytes,2,opt,name=nick_name,json=nickName,proto3" json:"nickName,omitempty"`
+		Score    *Int64Value  `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+		Missing  *StringValue `protobuf:"bytes,4,opt,name=missing,proto3" json:"missing,omitempty"`
+	}
+	node := &Node{
+		Data: map[string]interface{}{
+			"user_id":   int64(9),
+			"nick_name": "ace",
+			"score":     42,
+			"missing":   nil,
+		},
+	}
+	var reply UserReply
+	if err := node.StructScan(&reply); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if reply.UserId != 9 {
+		t.Errorf("Expected UserId 9, got %d", reply.UserId)
+	}
+	if reply.Nickname == nil || reply.Nickname.Value != "ace" {
+		t.Errorf("Expected Nickname wrapper 'ace', got %+v", reply.Nickname)
+	}
+	if reply.Score == nil || reply.Score.Value != 42 {
+		t.Errorf("Expected Score wrapper 42, got %+v", reply.Score)
+	}
+	if reply.Missing != nil {
+		t.Errorf("Expected nil wrapper for NULL, got %+v", reply.Missing)
+	}
+}
+
+func TestNext_ConcurrentModification(t *testing.T) {
+	ll := New()
+	for id := 3; id >= 1; id-- {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+
+	ll.ResetIterator()
+	ll.Next()
+	ll.Sort(lessByID)
+	if node := ll.Next(); node != nil {
+		t.Errorf("Expected Next to stop after Sort, got %+v", node.Data)
+	}
+	if !errors.Is(ll.Err(), ErrConcurrentModification) {
+		t.Errorf("Expected ErrConcurrentModification, got %v", ll.Err())
+	}
+
+	ll.ResetIterator()
+	if ll.Err() != nil {
+		t.Errorf("Expected ResetIterator to clear the error, got %v", ll.Err())
+	}
+	ll.Next()
+	ll.Append(map[string]interface{}{"ID": 4})
+	count := 1
+	for node := ll.Next(); node != nil; node = ll.Next() {
+		count++
+	}
+	if count != 4 || ll.Err() != nil {
+		t.Errorf("Expected appends to keep iteration valid, got %d nodes (err=%v)", count, ll.Err())
+	}
+}
+
+func TestNext_DebugPanics(t *testing.T) {
+	base := time.Now()
+	ll := New(WithDebug())
+	ll.AppendAt(map[string]interface{}{"ID": 1}, base.Add(-time.Hour))
+	ll.AppendAt(map[string]interface{}{"ID": 2}, base)
+
+	ll.ResetIterator()
+	ll.Next()
+	ll.ExpireOlderThan(time.Minute, SystemClock{})
+
+	defer func() {
+		if r := recover(); r != ErrConcurrentModification {
+			t.Errorf("Expected panic with ErrConcurrentModification, got %v", r)
+		}
+	}()
+	ll.Next()
+}
+
+func TestStructScan_TypedNilPointerValue(t *testing.T) {
+	type User struct {
+		Age *int
+	}
+	node := &Node{Data: map[string]interface{}{"Age": (*int64)(nil)}}
+	var u User
+	if err := node.StructScan(&u); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if u.Age != nil {
+		t.Errorf("Expected nil Age, got %v", *u.Age)
+	}
+}
+
+func TestStructScan_PanicBecomesError(t *testing.T) {
+	type Row struct {
+		Digest [4]byte
+	}
+	node := &Node{Data: map[string]interface{}{"Digest": []byte{1, 2}}}
+	var r Row
+	err := node.StructScan(&r)
+	var panicErr *ScanPanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Expected ScanPanicError, got %v", err)
+	}
+	if panicErr.FieldType != reflect.TypeOf([4]byte{}) || len(panicErr.Stack) == 0 {
+		t.Errorf("Expected panic context, got %+v", panicErr)
+	}
+}
+
+func TestSetFieldValue_Unsettable(t *testing.T) {
+	var i int
+	err := (*scanner)(nil).setFieldValue(reflect.ValueOf(i), reflect.TypeOf(i), 1)
+	if err == nil {
+		t.Error("Expected error for unsettable field, got nil")
+	}
+}
+
+func TestStructScan_NilNode(t *testing.T) {
+	type Dummy struct{ X int }
+	var n *Node
+	var d Dummy
+	if err := n.StructScan(&d); err == nil {
+		t.Error("Expected error scanning nil node, got nil")
+	}
+}
+
+type labelStringer string
+
+func (l labelStringer) String() string { return string(l) }
+
+func TestStructScan_InterfaceFields(t *testing.T) {
+	type Envelope struct {
+		Payload interface{}
+		Extra   any
+		Label   fmt.Stringer
+	}
+	payload := map[string]interface{}{"a": 1}
+	node := &Node{
+		Data: map[string]interface{}{
+			"Payload": payload,
+			"Extra":   int64(7),
+			"Label":   labelStringer("x"),
+		},
+	}
+	var e Envelope
+	if err := node.StructScan(&e); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if p, ok := e.Payload.(map[string]interface{}); !ok || p["a"] != 1 {
+		t.Errorf("Expected raw map payload, got %#v", e.Payload)
+	}
+	if e.Extra != int64(7) {
+		t.Errorf("Expected raw int64, got %#v", e.Extra)
+	}
+	if e.Label == nil || e.Label.String() != "x" {
+		t.Errorf("Expected Label stringer, got %#v", e.Label)
+	}
+
+	node.Data["Label"] = 42
+	if err := node.StructScan(&e); err == nil {
+		t.Error("Expected error assigning non-Stringer to fmt.Stringer field")
+	}
+}
+
+func TestStructScan_NestedMap(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+		Zip  int    `json:"zip"`
+	}
+	type User struct {
+		Name     string             `db:"name"`
+		Home     Address            `db:"home"`
+		Work     *Address           `db:"work"`
+		Settings map[string]int     `db:"settings"`
+		Raw      map[string]any     `db:"raw"`
+		Tags     map[string]*string `db:"tags"`
+	}
+	node := &Node{
+		Data: map[string]interface{}{
+			"name":     "Alice",
+			"home":     map[string]interface{}{"city": "Paris", "zip": int64(75001)},
+			"work":     map[string]interface{}{"CITY": "Lyon"},
+			"settings": map[string]interface{}{"volume": int64(3)},
+			"raw":      map[string]interface{}{"x": true},
+			"tags":     map[string]interface{}{"a": "b", "c": nil},
+		},
+	}
+	var u User
+	if err := node.StructScan(&u); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if u.Home.City != "Paris" || u.Home.Zip != 75001 {
+		t.Errorf("Unexpected Home: %+v", u.Home)
+	}
+	if u.Work == nil || u.Work.City != "Lyon" {
+		t.Errorf("Unexpected Work: %+v", u.Work)
+	}
+	if u.Settings["volume"] != 3 || u.Raw["x"] != true {
+		t.Errorf("Unexpected maps: %+v %+v", u.Settings, u.Raw)
+	}
+	if u.Tags["a"] == nil || *u.Tags["a"] != "b" || u.Tags["c"] != nil {
+		t.Errorf("Unexpected Tags: %+v", u.Tags)
+	}
+
+	node.Data["home"] = map[string]interface{}{"zip": "not-a-zip"}
+	if err := node.StructScan(&u); err == nil {
+		t.Error("Expected error from nested conversion, got nil")
+	}
+}
+
+func TestStructScan_SliceOfStructs(t *testing.T) {
+	type Item struct {
+		SKU string `db:"sku"`
+		Qty int    `db:"qty"`
+	}
+	type Order struct {
+		ID       int     `db:"id"`
+		Items    []Item  `db:"items"`
+		Pointers []*Item `db:"pointers"`
+		Loose    []Item  `db:"loose"`
+		IDs      []int   `db:"ids"`
+	}
+	node := &Node{
+		Data: map[string]interface{}{
+			"id": 1,
+			"items": []map[string]interface{}{
+				{"sku": "A", "qty": int64(2)},
+				{"sku": "B", "qty": int64(1)},
+			},
+			"pointers": []map[string]interface{}{{"sku": "C"}},
+			"loose":    []interface{}{map[string]interface{}{"sku": "D"}, nil},
+			"ids":      []interface{}{int64(1), 2.0},
+		},
+	}
+	var o Order
+	if err := node.StructScan(&o); err != nil {
+		t.Fatalf("StructScan failed: %v", err)
+	}
+	if len(o.Items) != 2 || o.Items[0].SKU != "A" || o.Items[0].Qty != 2 || o.Items[1].SKU != "B" {
+		t.Errorf("Unexpected Items: %+v", o.Items)
+	}
+	if len(o.Pointers) != 1 || o.Pointers[0] == nil || o.Pointers[0].SKU != "C" {
+		t.Errorf("Unexpected Pointers: %+v", o.Pointers)
+	}
+	if len(o.Loose) != 2 || o.Loose[0].SKU != "D" || o.Loose[1].SKU != "" {
+		t.Errorf("Unexpected Loose: %+v", o.Loose)
+	}
+	if len(o.IDs) != 2 || o.IDs[0] != 1 || o.IDs[1] != 2 {
+		t.Errorf("Unexpected IDs: %+v", o.IDs)
+	}
+
+	node.Data["items"] = []map[string]interface{}{{"qty": "many"}}
+	if err := node.StructScan(&o); err == nil {
+		t.Error("Expected error from child conversion, got nil")
+	}
+}
@@ -0,0 +1,171 @@
+// Package lltest provides test assertions for linked lists: golden-file
+// snapshots and row-by-row comparisons with readable diffs.
+package lltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// update rewrites golden files instead of comparing against them:
+//
+//	go test ./... -args -lltest.update
+var update = flag.Bool("lltest.update", false, "rewrite lltest golden files")
+
+// maxDiffs caps the differences reported by one assertion.
+const maxDiffs = 20
+
+// AssertMatchesGolden compares the rows of ll with the JSON golden file
+// testdata/<name>. Values are compared after a JSON round trip, so times and
+// numbers match their JSON form. Run the tests with -lltest.update to write
+// the golden file from ll.
+func AssertMatchesGolden(t testing.TB, ll *linkedlist.LinkedList, name string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	got, err := marshalRows(ll)
+	if err != nil {
+		t.Fatalf("lltest: cannot encode list: %v", err)
+	}
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("lltest: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("lltest: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("lltest: %v (run with -lltest.update to create it)", err)
+	}
+	wantRows, err := decodeRows(want)
+	if err != nil {
+		t.Fatalf("lltest: invalid golden file %s: %v", path, err)
+	}
+	gotRows, _ := decodeRows(got)
+	if diffs := diffRows(wantRows, gotRows, nil); len(diffs) > 0 {
+		t.Errorf("list does not match %s:\n%s", path, strings.Join(diffs, "\n"))
+	}
+}
+
+// AssertEqual reports every row and column where got differs from want,
+// ignoring the named columns. Numbers compare with the tolerance of
+// linkedlist.Equal.
+func AssertEqual(t testing.TB, want, got *linkedlist.LinkedList, ignoreColumns ...string) {
+	t.Helper()
+	if diffs := diffRows(rowsOf(want), rowsOf(got), ignoreColumns); len(diffs) > 0 {
+		t.Errorf("lists differ:\n%s", strings.Join(diffs, "\n"))
+	}
+}
+
+// rowsOf returns the Data of every resident row.
+func rowsOf(ll *linkedlist.LinkedList) []map[string]interface{} {
+	var rows []map[string]interface{}
+	for _, n := range ll.Nodes() {
+		rows = append(rows, n.Data)
+	}
+	return rows
+}
+
+// marshalRows encodes the rows as an indented JSON array with sorted keys.
+func marshalRows(ll *linkedlist.LinkedList) ([]byte, error) {
+	rows := rowsOf(ll)
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+	out, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// decodeRows decodes a JSON array of rows, keeping numbers exact.
+func decodeRows(data []byte) ([]map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var rows []map[string]interface{}
+	err := dec.Decode(&rows)
+	return rows, err
+}
+
+// diffRows describes the differences between two row sets, at most
+// maxDiffs of them.
+func diffRows(want, got []map[string]interface{}, ignore []string) []string {
+	ignored := make(map[string]bool, len(ignore))
+	for _, c := range ignore {
+		ignored[c] = true
+	}
+
+	var diffs []string
+	if len(want) != len(got) {
+		diffs = append(diffs, fmt.Sprintf("  row count: want %d, got %d", len(want), len(got)))
+	}
+	for i := 0; i < len(want) || i < len(got); i++ {
+		switch {
+		case i >= len(got):
+			diffs = append(diffs, fmt.Sprintf("  row %d: missing, want %v", i+1, want[i]))
+			continue
+		case i >= len(want):
+			diffs = append(diffs, fmt.Sprintf("  row %d: unexpected %v", i+1, got[i]))
+			continue
+		}
+		for _, c := range columnsOf(want[i], got[i]) {
+			if ignored[c] {
+				continue
+			}
+			wv, wok := want[i][c]
+			gv, gok := got[i][c]
+			switch {
+			case !wok:
+				diffs = append(diffs, fmt.Sprintf("  row %d, column %q: unexpected %#v", i+1, c, gv))
+			case !gok:
+				diffs = append(diffs, fmt.Sprintf("  row %d, column %q: missing, want %#v", i+1, c, wv))
+			case !valueEqual(wv, gv):
+				diffs = append(diffs, fmt.Sprintf("  row %d, column %q: want %#v, got %#v", i+1, c, wv, gv))
+			}
+		}
+	}
+	if len(diffs) > maxDiffs {
+		diffs = append(diffs[:maxDiffs], fmt.Sprintf("  ... and %d more differences", len(diffs)-maxDiffs))
+	}
+	return diffs
+}
+
+// columnsOf returns the sorted union of the columns of two rows.
+func columnsOf(a, b map[string]interface{}) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	for c := range a {
+		seen[c] = true
+	}
+	for c := range b {
+		seen[c] = true
+	}
+	columns := make([]string, 0, len(seen))
+	for c := range seen {
+		columns = append(columns, c)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// valueEqual compares two values like linkedlist.Equal does.
+func valueEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return linkedlist.DefaultRowEqual(map[string]interface{}{"v": a}, map[string]interface{}{"v": b})
+}
@@ -0,0 +1,88 @@
+package lltest
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// recorder captures assertion failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// capture runs an assertion and returns its failures.
+func capture(t *testing.T, assert func(tb testing.TB)) []string {
+	r := &recorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert(r)
+	}()
+	<-done
+	return r.failures
+}
+
+func users() *linkedlist.LinkedList {
+	ll := linkedlist.New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice", "joined": time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+	ll.Append(map[string]interface{}{"id": 2, "name": "Bob", "joined": nil})
+	return ll
+}
+
+func TestAssertMatchesGolden(t *testing.T) {
+	AssertMatchesGolden(t, users(), "users.json")
+
+	changed := users()
+	changed.Last().Set("name", "Robert")
+	failures := capture(t, func(tb testing.TB) { AssertMatchesGolden(tb, changed, "users.json") })
+	if len(failures) != 1 || !strings.Contains(failures[0], `row 2, column "name": want "Bob", got "Robert"`) {
+		t.Errorf("Unexpected failures: %q", failures)
+	}
+
+	failures = capture(t, func(tb testing.TB) { AssertMatchesGolden(tb, users(), "missing.json") })
+	if len(failures) != 1 || !strings.Contains(failures[0], "-lltest.update") {
+		t.Errorf("Expected a hint about -lltest.update, got %q", failures)
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	want := users()
+	got := users()
+	got.First().Set("id", int64(1))
+	got.First().Set("name", "Alicia")
+	got.Last().Set("email", "bob@example.com")
+	got.Append(map[string]interface{}{"id": 3})
+
+	failures := capture(t, func(tb testing.TB) { AssertEqual(tb, want, got, "email") })
+	if len(failures) != 1 {
+		t.Fatalf("Expected one failure, got %q", failures)
+	}
+	msg := failures[0]
+	for _, part := range []string{"row count: want 2, got 3", `row 1, column "name": want "Alice", got "Alicia"`, "row 3: unexpected"} {
+		if !strings.Contains(msg, part) {
+			t.Errorf("Expected %q in:\n%s", part, msg)
+		}
+	}
+	if strings.Contains(msg, `"id"`) || strings.Contains(msg, "email") {
+		t.Errorf("Expected numeric ids to match and email to be ignored:\n%s", msg)
+	}
+
+	AssertEqual(t, users(), users())
+}
@@ -0,0 +1,63 @@
+package lltest
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sort"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// RowsFromList returns mocked query results holding the rows of ll, for use
+// with mock.ExpectQuery(...).WillReturnRows. The columns default to those of
+// all rows in name order; rows missing a column return NULL.
+func RowsFromList(ll *linkedlist.LinkedList, columns ...string) *sqlmock.Rows {
+	nodes := ll.Nodes()
+	if len(columns) == 0 {
+		seen := make(map[string]bool)
+		for _, n := range nodes {
+			for c := range n.Data {
+				if !seen[c] {
+					seen[c] = true
+					columns = append(columns, c)
+				}
+			}
+		}
+		sort.Strings(columns)
+	}
+
+	rows := sqlmock.NewRows(columns)
+	for _, n := range nodes {
+		values := make([]driver.Value, len(columns))
+		for i, c := range columns {
+			values[i] = n.Data[c]
+		}
+		rows.AddRow(values...)
+	}
+	return rows
+}
+
+// ListFromRows loads mocked query results into a list, exactly as
+// LoadFromSQLx would load them from a real query.
+func ListFromRows(rows *sqlmock.Rows) (*linkedlist.LinkedList, error) {
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sqlmock DB: %w", err)
+	}
+	defer sqlDB.Close()
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+
+	result, err := sqlx.NewDb(sqlDB, "sqlmock").Queryx("SELECT")
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	ll := linkedlist.New()
+	if err := ll.LoadFromSQLx(result); err != nil {
+		return nil, err
+	}
+	return ll, nil
+}
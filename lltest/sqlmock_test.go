@@ -0,0 +1,46 @@
+package lltest
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+func TestRowsFromList_WithMockQuery(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	defer sqlDB.Close()
+	mock.ExpectQuery("SELECT").WillReturnRows(RowsFromList(users()))
+
+	rows, err := sqlx.NewDb(sqlDB, "sqlmock").Queryx("SELECT * FROM users")
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	defer rows.Close()
+	ll := linkedlist.New()
+	if err := ll.LoadFromSQLx(rows); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	AssertEqual(t, users(), ll)
+}
+
+func TestListFromRows_RoundTrip(t *testing.T) {
+	ll, err := ListFromRows(RowsFromList(users(), "name", "id"))
+	if err != nil {
+		t.Fatalf("ListFromRows failed: %v", err)
+	}
+	if ll.Len() != 2 || len(ll.First().Data) != 2 {
+		t.Fatalf("Expected 2 rows of 2 columns, got %d rows: %v", ll.Len(), ll.First().Data)
+	}
+	AssertEqual(t, users(), ll, "joined")
+
+	empty, err := ListFromRows(sqlmock.NewRows([]string{"id"}))
+	if err != nil || empty.Len() != 0 {
+		t.Errorf("Expected empty list, got %d rows (%v)", empty.Len(), err)
+	}
+}
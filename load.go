@@ -0,0 +1,248 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// loadProgressInterval is how many rows pass between load progress logs.
+const loadProgressInterval = 10000
+
+// LoadOptions controls how rows are turned into nodes while loading.
+type LoadOptions struct {
+	// RowNumbers records the 1-based ordinal of each row, see Node.RowNum.
+	RowNumbers bool
+	// Source labels every loaded node, see Node.Source.
+	Source string
+	// ArrayColumns are parsed from Postgres array literals into
+	// []interface{}, see ParsePGArray. Columns the driver reports with an
+	// array type such as _INT4 are parsed without being listed, their
+	// integer, float and boolean elements typed.
+	ArrayColumns []string
+	// UUIDColumns are normalized to canonical lower-case UUID strings, see
+	// NormalizeUUID, so keys from different drivers compare equal.
+	UUIDColumns []string
+	// DetectUUIDs also normalizes columns the driver reports as UUID or
+	// UNIQUEIDENTIFIER. Raw UNIQUEIDENTIFIER bytes are reordered from SQL
+	// Server's mixed-endian layout.
+	DetectUUIDs bool
+	// CheckpointEvery, when positive, calls OnCheckpoint after every
+	// CheckpointEvery loaded rows and once more when the load finishes.
+	CheckpointEvery int
+	// OnCheckpoint receives checkpoints; an error stops the load.
+	OnCheckpoint func(Checkpoint) error
+	// CheckpointKey names the column whose value checkpoints record. For
+	// key-based resumes the result should be ordered by it and its values
+	// must be unique.
+	CheckpointKey string
+	// ResumeFrom continues a load from a checkpoint. With a key, the rows
+	// are expected to start after it, see QueryWithOptions; otherwise the
+	// first Offset rows are skipped. Row numbers and later checkpoints
+	// continue from the checkpoint's offset.
+	ResumeFrom *Checkpoint
+	// MaxBytes, when positive, caps the size of the rows held in memory,
+	// estimated as EstimatedSize does. Lists with a spill file move their oldest
+	// rows to disk to stay within it; other loads stop with ErrMemoryBudget
+	// before the row that would exceed it.
+	MaxBytes int64
+	// RollbackOnError removes the rows a failed load appended, so the list
+	// is left as it was. Lists created with WithMaxLen or WithSpill may
+	// evict or spill rows during the load, which cannot be undone, so
+	// loading into them with this option fails before reading any row.
+	RollbackOnError bool
+	// Transformers names RowTransformers, see RegisterTransformer, run in
+	// order over every row as it is loaded.
+	Transformers []string
+	// Blobs keeps large binary columns from being loaded whole.
+	Blobs BlobOptions
+	// KeyCase rewrites column names before they become Data keys. Names
+	// that become equal are handled as DuplicateColumns says.
+	KeyCase KeyCase
+	// DuplicateColumns decides how repeated column names are loaded. By
+	// default the last column of a name overwrites the others.
+	DuplicateColumns DuplicatePolicy
+	// ColumnTables names the table of each result column, in order, for
+	// DuplicateTablePrefix. The database driver does not report them.
+	ColumnTables []string
+}
+
+// LoadFromSQLxWithOptions loads data from sqlx rows into the linked list
+// using the given options.
+func (ll *LinkedList) LoadFromSQLxWithOptions(rows *sqlx.Rows, opts LoadOptions) error {
+	return ll.LoadFromSQLxContext(context.Background(), rows, opts)
+}
+
+// LoadFromSQLxContext is LoadFromSQLxWithOptions with a context for tracing.
+// Loading stops with the context's error once ctx is done. Failures while
+// reading rows are returned as *PartialLoadError; failures before the first
+// row, such as invalid options, are returned as they are.
+func (ll *LinkedList) LoadFromSQLxContext(ctx context.Context, rows *sqlx.Rows, opts LoadOptions) (err error) {
+	if ll == nil {
+		return ErrNilList
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if opts.RollbackOnError && (ll.maxLen > 0 || ll.spill != nil) {
+		return errors.New("RollbackOnError is not supported on lists with a maximum length or spill file")
+	}
+	_, span := ll.startSpan(ctx, "LoadFromSQLx")
+	rowNum := 0
+	reading := false
+	var loaded map[*Node]bool
+	if opts.RollbackOnError {
+		loaded = make(map[*Node]bool)
+	}
+	defer func() {
+		if err != nil && reading {
+			pe := &PartialLoadError{Rows: rowNum, Err: err}
+			if loaded != nil {
+				ll.rollbackLoad(loaded)
+				pe.RolledBack = true
+			}
+			err = pe
+		}
+		if ll.logger != nil {
+			ll.logger.Debug("linkedlist: load finished", "source", opts.Source, "rows", rowNum, "error", err)
+		}
+		ll.record("load", rowNum, "LoadFromSQLx source=%q", opts.Source)
+		if opts.Source != "" {
+			span.SetAttribute("linkedlist.source", opts.Source)
+		}
+		endSpan(span, rowNum, err)
+	}()
+	pipe, err := newRowPipeline(rows, opts)
+	if err != nil {
+		return err
+	}
+	ll.noteColumns(pipe.cols)
+	if pipe.blobs != nil {
+		ll.noteColumns(pipe.blobs.missing)
+	}
+	ckpt := newCheckpointer(opts)
+	budget := ll.newLoadBudget(opts.MaxBytes)
+	ll.budget = budget
+	defer func() { ll.budget = nil }()
+	reading = true
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if ckpt.skip() {
+			continue
+		}
+		rowData, err := pipe.scan(rows)
+		if err != nil {
+			ll.noteError("load", err)
+			return err
+		}
+		ll.normalizeTimes(rowData)
+		if err := budget.admit(ll, estimateSize(rowData)); err != nil {
+			ll.noteError("load", err)
+			return err
+		}
+		rowNum++
+		ll.noteLoadRow(rowData)
+		if ll.logger != nil && rowNum%loadProgressInterval == 0 {
+			ll.logger.Debug("linkedlist: load progress", "source", opts.Source, "rows", rowNum)
+		}
+
+		node := &Node{Data: rowData, source: opts.Source}
+		if opts.RowNumbers {
+			node.rowNum = ckpt.last.Offset + 1
+		}
+		if ll.clock != nil {
+			node.timestamp = ll.clock.Now()
+		}
+		ll.appendNode(node)
+		if loaded != nil {
+			loaded[node] = true
+		}
+		if err := budget.release(ll); err != nil {
+			ll.noteError("load", err)
+			return err
+		}
+		if err := ckpt.advance(rowData); err != nil {
+			ll.noteError("load", err)
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		ll.noteError("load", err)
+		return err
+	}
+	reading = false
+	return ckpt.flush()
+}
+
+// rowPipeline turns the rows of a result set into Data maps as the column
+// options of LoadOptions say: column names, blobs, transformers, arrays and
+// UUIDs.
+type rowPipeline struct {
+	cols       []string
+	transforms []RowTransformer
+	arrays     map[string]string
+	uuids      map[string]bool
+	blobs      *blobPlan
+}
+
+// newRowPipeline prepares the pipeline for rows.
+func newRowPipeline(rows *sqlx.Rows, opts LoadOptions) (*rowPipeline, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+	cols, err = resolveColumns(cols, opts)
+	if err != nil {
+		return nil, err
+	}
+	transforms, err := lookupTransformers(opts.Transformers)
+	if err != nil {
+		return nil, err
+	}
+	types, _ := rows.ColumnTypes()
+	blobs, err := newBlobPlan(opts.Blobs, cols, types)
+	if err != nil {
+		return nil, err
+	}
+	return &rowPipeline{
+		cols:       cols,
+		transforms: transforms,
+		arrays:     arrayColumns(opts.ArrayColumns, types, cols),
+		uuids:      uuidColumns(opts.UUIDColumns, opts.DetectUUIDs, types, cols),
+		blobs:      blobs,
+	}, nil
+}
+
+// scan reads the current row of rows.
+func (p *rowPipeline) scan(rows *sqlx.Rows) (map[string]interface{}, error) {
+	rowData, err := scanRowBlobs(rows, p.cols, p.blobs)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyTransformers(rowData, p.transforms); err != nil {
+		return nil, err
+	}
+	if err := parseArrayColumns(rowData, p.arrays); err != nil {
+		return nil, err
+	}
+	if err := normalizeUUIDColumns(rowData, p.uuids); err != nil {
+		return nil, err
+	}
+	return rowData, nil
+}
+
+// RowNum returns the 1-based ordinal of the row the node was loaded from, or
+// 0 when row numbers were not recorded.
+func (n *Node) RowNum() int {
+	return n.rowNum
+}
+
+// Source returns the source label the node was loaded with.
+func (n *Node) Source() string {
+	return n.source
+}
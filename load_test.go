@@ -0,0 +1,68 @@
+package linkedlist
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+// queryRows returns sqlx rows backed by sqlmock for the given columns and values.
+func queryRows(t *testing.T, cols []string, values ...[]interface{}) *sqlx.Rows {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+
+	rows := sqlmock.NewRows(cols)
+	for _, v := range values {
+		driverValues := make([]driver.Value, len(v))
+		for i := range v {
+			driverValues[i] = v[i]
+		}
+		rows.AddRow(driverValues...)
+	}
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+
+	sqlxRows, err := db.Queryx("SELECT")
+	if err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	t.Cleanup(func() { sqlxRows.Close() })
+	return sqlxRows
+}
+
+func TestLoadFromSQLxWithOptions_RowNumAndSource(t *testing.T) {
+	rows := queryRows(t, []string{"id"}, []interface{}{1}, []interface{}{2})
+
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{RowNumbers: true, Source: "users@primary"})
+	if err != nil {
+		t.Fatalf("LoadFromSQLxWithOptions failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", ll.Len())
+	}
+	if ll.First().RowNum() != 1 || ll.Last().RowNum() != 2 {
+		t.Errorf("Unexpected row numbers: %d, %d", ll.First().RowNum(), ll.Last().RowNum())
+	}
+	if ll.Last().Source() != "users@primary" {
+		t.Errorf("Expected source 'users@primary', got '%s'", ll.Last().Source())
+	}
+}
+
+func TestLoadFromSQLx_NoRowNumbers(t *testing.T) {
+	rows := queryRows(t, []string{"id"}, []interface{}{1})
+
+	ll := New()
+	if err := ll.LoadFromSQLx(rows); err != nil {
+		t.Fatalf("LoadFromSQLx failed: %v", err)
+	}
+	if ll.First().RowNum() != 0 || ll.First().Source() != "" {
+		t.Errorf("Expected no row tagging, got row %d source '%s'", ll.First().RowNum(), ll.First().Source())
+	}
+}
@@ -0,0 +1,177 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// RowSource yields rows for LoadConcurrent, such as the cursor of one shard
+// query.
+type RowSource interface {
+	// NextRow returns the next row, or io.EOF when the source is exhausted.
+	NextRow(ctx context.Context) (map[string]interface{}, error)
+}
+
+// sqlxSource adapts *sqlx.Rows to RowSource.
+type sqlxSource struct {
+	rows *sqlx.Rows
+	opts LoadOptions
+	pipe *rowPipeline
+}
+
+// SQLxSource returns a RowSource scanning rows as LoadFromSQLx does. The
+// caller still closes rows.
+func SQLxSource(rows *sqlx.Rows) RowSource {
+	return SQLxSourceWithOptions(rows, LoadOptions{})
+}
+
+// SQLxSourceWithOptions is SQLxSource scanning rows as
+// LoadFromSQLxWithOptions does with opts. Only the options that shape a row
+// apply; the first NextRow fails if opts sets one about the list being
+// loaded, such as Source, RowNumbers, checkpoints, MaxBytes or
+// RollbackOnError.
+func SQLxSourceWithOptions(rows *sqlx.Rows, opts LoadOptions) RowSource {
+	return &sqlxSource{rows: rows, opts: opts}
+}
+
+func (s *sqlxSource) NextRow(ctx context.Context) (map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s.pipe == nil {
+		if err := checkSourceOptions(s.opts); err != nil {
+			return nil, err
+		}
+		pipe, err := newRowPipeline(s.rows, s.opts)
+		if err != nil {
+			return nil, err
+		}
+		s.pipe = pipe
+	}
+	if !s.rows.Next() {
+		if err := s.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.pipe.scan(s.rows)
+}
+
+// checkSourceOptions rejects the LoadOptions a RowSource cannot honour.
+func checkSourceOptions(opts LoadOptions) error {
+	var name string
+	switch {
+	case opts.RowNumbers:
+		name = "RowNumbers"
+	case opts.Source != "":
+		name = "Source"
+	case opts.CheckpointEvery != 0 || opts.OnCheckpoint != nil || opts.CheckpointKey != "" || opts.ResumeFrom != nil:
+		name = "checkpoint"
+	case opts.MaxBytes != 0:
+		name = "MaxBytes"
+	case opts.RollbackOnError:
+		name = "RollbackOnError"
+	default:
+		return nil
+	}
+	return fmt.Errorf("LoadOptions: %s is not supported by SQLxSourceWithOptions", name)
+}
+
+// LoadOrder selects how LoadConcurrentOrdered arranges rows from several
+// sources.
+type LoadOrder int
+
+const (
+	// SourceGrouped keeps all rows of the first source, then all of the
+	// second and so on, as loading the sources one after another would.
+	SourceGrouped LoadOrder = iota
+	// Interleaved takes one row from each source in turn, skipping sources
+	// that are exhausted.
+	Interleaved
+)
+
+// LoadConcurrent scans sources in parallel, at most workers at a time, into
+// a new list with the rows grouped by source in order. A non-positive
+// workers scans every source at once. The first error cancels the other
+// scans and is returned with the index of its source.
+func LoadConcurrent(ctx context.Context, sources []RowSource, workers int) (*LinkedList, error) {
+	return LoadConcurrentOrdered(ctx, sources, workers, SourceGrouped)
+}
+
+// LoadConcurrentOrdered is LoadConcurrent with a choice of row order.
+func LoadConcurrentOrdered(ctx context.Context, sources []RowSource, workers int, order LoadOrder) (*LinkedList, error) {
+	if order != SourceGrouped && order != Interleaved {
+		return nil, fmt.Errorf("unknown load order %d", order)
+	}
+	if workers <= 0 || workers > len(sources) {
+		workers = len(sources)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]map[string]interface{}, len(sources))
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, max(workers, 1))
+	for i, src := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			for {
+				row, err := src.NextRow(ctx)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("source %d: %w", i, err)
+						cancel()
+					})
+					return
+				}
+				results[i] = append(results[i], row)
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ll := New()
+	if order == SourceGrouped {
+		for _, rows := range results {
+			for _, row := range rows {
+				ll.Append(row)
+			}
+		}
+		return ll, nil
+	}
+	for pos, more := 0, true; more; pos++ {
+		more = false
+		for _, rows := range results {
+			if pos < len(rows) {
+				ll.Append(rows[pos])
+				more = true
+			}
+		}
+	}
+	return ll, nil
+}
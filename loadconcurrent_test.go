@@ -0,0 +1,146 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sliceSource yields the given ids as rows, sleeping between rows.
+type sliceSource struct {
+	ids    []int
+	delay  time.Duration
+	err    error
+	active *int32
+	peak   *int32
+}
+
+func (s *sliceSource) NextRow(ctx context.Context) (map[string]interface{}, error) {
+	if s.active != nil {
+		n := atomic.AddInt32(s.active, 1)
+		defer atomic.AddInt32(s.active, -1)
+		for {
+			p := atomic.LoadInt32(s.peak)
+			if n <= p || atomic.CompareAndSwapInt32(s.peak, p, n) {
+				break
+			}
+		}
+	}
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if len(s.ids) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	id := s.ids[0]
+	s.ids = s.ids[1:]
+	return map[string]interface{}{"id": id}, nil
+}
+
+func loadedIDs(ll *LinkedList) []interface{} {
+	return columnValues(ll, "id")
+}
+
+func TestLoadConcurrentSourceGrouped(t *testing.T) {
+	sources := []RowSource{
+		&sliceSource{ids: []int{1, 2, 3}, delay: 2 * time.Millisecond},
+		&sliceSource{ids: []int{4, 5}},
+		&sliceSource{ids: nil},
+		&sliceSource{ids: []int{6}},
+	}
+	ll, err := LoadConcurrent(context.Background(), sources, 0)
+	if err != nil {
+		t.Fatalf("LoadConcurrent failed: %v", err)
+	}
+	want := []interface{}{1, 2, 3, 4, 5, 6}
+	if got := loadedIDs(ll); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestLoadConcurrentInterleaved(t *testing.T) {
+	sources := []RowSource{
+		&sliceSource{ids: []int{1, 2, 3}},
+		&sliceSource{ids: []int{10}},
+		&sliceSource{ids: []int{20, 21}},
+	}
+	ll, err := LoadConcurrentOrdered(context.Background(), sources, 2, Interleaved)
+	if err != nil {
+		t.Fatalf("LoadConcurrentOrdered failed: %v", err)
+	}
+	want := []interface{}{1, 10, 20, 2, 21, 3}
+	if got := loadedIDs(ll); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestLoadConcurrentLimitsWorkers(t *testing.T) {
+	var active, peak int32
+	var sources []RowSource
+	for i := 0; i < 6; i++ {
+		sources = append(sources, &sliceSource{ids: []int{i, i}, delay: time.Millisecond, active: &active, peak: &peak})
+	}
+	if _, err := LoadConcurrent(context.Background(), sources, 2); err != nil {
+		t.Fatalf("LoadConcurrent failed: %v", err)
+	}
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent scans, got %d", peak)
+	}
+}
+
+func TestLoadConcurrentError(t *testing.T) {
+	boom := errors.New("shard down")
+	sources := []RowSource{
+		&sliceSource{ids: []int{1, 2, 3, 4, 5, 6, 7, 8}, delay: 5 * time.Millisecond},
+		&sliceSource{ids: []int{9}, err: boom},
+	}
+	start := time.Now()
+	_, err := LoadConcurrent(context.Background(), sources, 0)
+	if !errors.Is(err, boom) {
+		t.Fatalf("Expected shard error, got %v", err)
+	}
+	if time.Since(start) > 35*time.Millisecond {
+		t.Errorf("Expected the error to cancel the other scan, took %v", time.Since(start))
+	}
+}
+
+func TestSQLxSource(t *testing.T) {
+	a := queryRows(t, []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)})
+	b := queryRows(t, []string{"id"}, []interface{}{int64(3)})
+	ll, err := LoadConcurrent(context.Background(), []RowSource{SQLxSource(a), SQLxSource(b)}, 2)
+	if err != nil {
+		t.Fatalf("LoadConcurrent failed: %v", err)
+	}
+	want := []interface{}{int64(1), int64(2), int64(3)}
+	if got := loadedIDs(ll); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSQLxSourceWithOptions(t *testing.T) {
+	rows := queryRows(t, []string{"UserID"}, []interface{}{int64(1)}, []interface{}{int64(2)})
+	src := SQLxSourceWithOptions(rows, LoadOptions{KeyCase: Snake})
+	ll, err := LoadConcurrent(context.Background(), []RowSource{src}, 1)
+	if err != nil {
+		t.Fatalf("LoadConcurrent failed: %v", err)
+	}
+	want := []interface{}{int64(1), int64(2)}
+	if got := columnValues(ll, "user_id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v under user_id, got %v", want, got)
+	}
+
+	rows = queryRows(t, []string{"id"}, []interface{}{int64(1)})
+	src = SQLxSourceWithOptions(rows, LoadOptions{Source: "a"})
+	if _, err := LoadConcurrent(context.Background(), []RowSource{src}, 1); err == nil {
+		t.Error("Expected an error for a list-level option")
+	}
+}
@@ -0,0 +1,37 @@
+package linkedlist
+
+// SetMeta attaches a metadata value to the list under key. Metadata is kept
+// separate from node Data and is never scanned or exported.
+func (ll *LinkedList) SetMeta(key string, value interface{}) {
+	if ll == nil {
+		return
+	}
+	if ll.meta == nil {
+		ll.meta = make(map[string]interface{})
+	}
+	ll.meta[key] = value
+}
+
+// Meta returns the list metadata stored under key.
+func (ll *LinkedList) Meta(key string) (interface{}, bool) {
+	if ll == nil {
+		return nil, false
+	}
+	value, ok := ll.meta[key]
+	return value, ok
+}
+
+// SetMeta attaches a metadata value to the node under key, such as the source
+// query, load time or shard id the row came from.
+func (n *Node) SetMeta(key string, value interface{}) {
+	if n.meta == nil {
+		n.meta = make(map[string]interface{})
+	}
+	n.meta[key] = value
+}
+
+// Meta returns the node metadata stored under key.
+func (n *Node) Meta(key string) (interface{}, bool) {
+	value, ok := n.meta[key]
+	return value, ok
+}
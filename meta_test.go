@@ -0,0 +1,30 @@
+package linkedlist
+
+import "testing"
+
+func TestListMeta(t *testing.T) {
+	ll := New()
+	if _, ok := ll.Meta("source"); ok {
+		t.Error("Expected no metadata on new list")
+	}
+	ll.SetMeta("source", "SELECT * FROM users")
+	v, ok := ll.Meta("source")
+	if !ok || v != "SELECT * FROM users" {
+		t.Errorf("Expected source metadata, got %v (ok=%v)", v, ok)
+	}
+}
+
+func TestNodeMeta(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1})
+	node := ll.First()
+	node.SetMeta("shard", 3)
+
+	v, ok := node.Meta("shard")
+	if !ok || v != 3 {
+		t.Errorf("Expected shard metadata 3, got %v (ok=%v)", v, ok)
+	}
+	if _, found := node.Data["shard"]; found {
+		t.Error("Node metadata must not leak into Data")
+	}
+}
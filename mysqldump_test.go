@@ -0,0 +1,43 @@
+package linkedlist
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestWriteMySQLDump(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"id":     1,
+		"note":   "a\tb\nc\\d\x00",
+		"active": true,
+		"at":     time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC),
+		"score":  2.5,
+	})
+	ll.Append(map[string]interface{}{"id": 2, "note": nil, "active": false})
+
+	var buf bytes.Buffer
+	err := ll.WriteMySQLDump(&buf, MySQLDumpOptions{Columns: []string{"id", "note", "active", "at", "score"}, Header: true})
+	if err != nil {
+		t.Fatalf("WriteMySQLDump failed: %v", err)
+	}
+	want := "id\tnote\tactive\tat\tscore\n" +
+		"1\ta\\tb\\nc\\\\d\\0\t1\t2024-01-02 03:04:05.5\t2.5\n" +
+		"2\t\\N\t0\t\\N\t\\N\n"
+	if buf.String() != want {
+		t.Errorf("Expected:\n%q\ngot:\n%q", want, buf.String())
+	}
+}
+
+func TestWriteMySQLDump_DefaultColumnsAndLocation(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"b": 1, "a": time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)})
+	var buf bytes.Buffer
+	if err := ll.WriteMySQLDump(&buf, MySQLDumpOptions{Location: time.FixedZone("X", 3600)}); err != nil {
+		t.Fatalf("WriteMySQLDump failed: %v", err)
+	}
+	if buf.String() != "2024-01-01 13:00:00\t1\n" {
+		t.Errorf("Unexpected output: %q", buf.String())
+	}
+}
@@ -0,0 +1,32 @@
+package linkedlist
+
+import "log/slog"
+
+// Option configures a LinkedList created by New.
+type Option func(*LinkedList)
+
+// WithMaxLen bounds the list to n nodes. Once full, every Append evicts the
+// head node and passes it to onEvict, which may be nil. A non-positive n
+// leaves the list unbounded.
+func WithMaxLen(n int, onEvict func(*Node)) Option {
+	return func(ll *LinkedList) {
+		ll.maxLen = n
+		ll.onEvict = onEvict
+	}
+}
+
+// WithDebug makes Next panic with ErrConcurrentModification instead of
+// stopping quietly when the list is modified during iteration.
+func WithDebug() Option {
+	return func(ll *LinkedList) {
+		ll.debug = true
+	}
+}
+
+// WithLogger sends debug logs about load progress and struct fields left
+// unset while scanning to l.
+func WithLogger(l *slog.Logger) Option {
+	return func(ll *LinkedList) {
+		ll.logger = l
+	}
+}
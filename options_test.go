@@ -0,0 +1,81 @@
+package linkedlist
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestWithMaxLen_EvictsFromHead(t *testing.T) {
+	var evicted []int
+	ll := New(WithMaxLen(2, func(n *Node) {
+		evicted = append(evicted, n.Data["ID"].(int))
+	}))
+	for id := 1; id <= 4; id++ {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+
+	if ll.Len() != 2 {
+		t.Errorf("Expected Len() to be 2, got %d", ll.Len())
+	}
+	if ll.First().Data["ID"] != 3 || ll.Last().Data["ID"] != 4 {
+		t.Errorf("Unexpected window: first %v, last %v", ll.First().Data, ll.Last().Data)
+	}
+	if len(evicted) != 2 || evicted[0] != 1 || evicted[1] != 2 {
+		t.Errorf("Expected evicted ids [1 2], got %v", evicted)
+	}
+}
+
+func TestWithMaxLen_NilCallback(t *testing.T) {
+	ll := New(WithMaxLen(1, nil))
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.Append(map[string]interface{}{"ID": 2})
+	if ll.Len() != 1 || ll.First().Data["ID"] != 2 {
+		t.Errorf("Expected single node with ID 2, got len %d", ll.Len())
+	}
+	ll.ResetIterator()
+	if node := ll.Next(); node == nil || node.Data["ID"] != 2 {
+		t.Errorf("Expected iteration to start at ID 2, got %+v", node)
+	}
+}
+
+func TestWithMaxLen_Unbounded(t *testing.T) {
+	ll := New(WithMaxLen(0, nil))
+	for id := 0; id < 10; id++ {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+	if ll.Len() != 10 {
+		t.Errorf("Expected Len() to be 10, got %d", ll.Len())
+	}
+}
+
+func TestWithLogger_ScanAndLoad(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ll := New(WithLogger(logger))
+	if err := ll.LoadFromSQLxWithOptions(queryRows(t, []string{"NAME", "age"}, []interface{}{"Alice", nil}), LoadOptions{Source: "people"}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var p struct {
+		Name  string `db:"name"`
+		Age   int    `db:"age"`
+		Email string `db:"email"`
+	}
+	if err := ll.ScanFirst(&p); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="linkedlist: load finished" source=people rows=1`,
+		`msg="linkedlist: matched column ignoring case" field=Name column=NAME`,
+		`msg="linkedlist: skipped NULL column" field=Age column=age`,
+		`msg="linkedlist: no column for field" field=Email column=email`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected log to contain %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package linkedlist
+
+import (
+	"errors"
+	"sort"
+)
+
+// EditOp is the kind of an Edit.
+type EditOp int
+
+const (
+	// EditDelete removes a row of a.
+	EditDelete EditOp = iota
+	// EditInsert adds a row of b.
+	EditInsert
+	// EditMove moves a row of a to a new position.
+	EditMove
+)
+
+// String returns the op's name.
+func (op EditOp) String() string {
+	switch op {
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	case EditMove:
+		return "move"
+	}
+	return "unknown"
+}
+
+// Edit is one step of an OrderedDiff edit script.
+type Edit struct {
+	Op  EditOp
+	Key interface{}
+	// From is the row's position in a, or -1 for inserts.
+	From int
+	// To is the row's position in b, or -1 for deletes.
+	To int
+	// Node is the row in b, or in a for deletes.
+	Node *Node
+}
+
+// OrderedDiff returns a minimal edit script turning the order of a's keys
+// into b's. The rows of a longest common subsequence of the key orders are
+// left alone; of the rest, keys only in a are deleted, keys only in b
+// inserted and keys in both moved. The script lists deletes by From, then
+// inserts and moves by To, so applying it in order yields b.
+// Keys must be unique in each list; changed values are not reported.
+func OrderedDiff(a, b *LinkedList, key string) ([]Edit, error) {
+	if key == "" {
+		return nil, errors.New("diff key must not be empty")
+	}
+	if _, err := indexUnique(a, key, "first"); err != nil {
+		return nil, err
+	}
+	if _, err := indexUnique(b, key, "second"); err != nil {
+		return nil, err
+	}
+	aNodes, aKeys := keySequence(a, key)
+	bNodes, bKeys := keySequence(b, key)
+	keepA, keepB := commonKeep(aKeys, bKeys)
+
+	fromA := make(map[string]int)
+	for i, k := range aKeys {
+		if !keepA[i] {
+			fromA[k] = i
+		}
+	}
+	var edits []Edit
+	inB := make(map[string]bool)
+	for j, k := range bKeys {
+		if !keepB[j] {
+			inB[k] = true
+		}
+	}
+	for i, k := range aKeys {
+		if !keepA[i] && !inB[k] {
+			edits = append(edits, Edit{Op: EditDelete, Key: aNodes[i].Data[key], From: i, To: -1, Node: aNodes[i]})
+		}
+	}
+	for j, k := range bKeys {
+		if keepB[j] {
+			continue
+		}
+		e := Edit{Op: EditInsert, Key: bNodes[j].Data[key], From: -1, To: j, Node: bNodes[j]}
+		if i, ok := fromA[k]; ok {
+			e.Op, e.From = EditMove, i
+		}
+		edits = append(edits, e)
+	}
+	return edits, nil
+}
+
+// keySequence returns the nodes of ll and their encoded keys.
+func keySequence(ll *LinkedList, key string) ([]*Node, []string) {
+	var nodes []*Node
+	var keys []string
+	ll.each(func(n *Node) error {
+		nodes = append(nodes, n)
+		keys = append(keys, encodeKey([]interface{}{n.Data[key]}))
+		return nil
+	})
+	return nodes, keys
+}
+
+// commonKeep marks the elements of a longest common subsequence of a and b,
+// whose elements are unique. Such a subsequence is a longest increasing run
+// of the positions in a of b's common elements, found in O(n log n) time
+// and linear space by patience sorting.
+func commonKeep(a, b []string) (keepA, keepB []bool) {
+	keepA, keepB = make([]bool, len(a)), make([]bool, len(b))
+	posA := make(map[string]int, len(a))
+	for i, k := range a {
+		posA[k] = i
+	}
+
+	var js []int    // indexes in b of the common elements
+	var tails []int // tails[l] indexes js: smallest tail of a run of length l+1
+	var prev []int  // prev[i] indexes js: predecessor of js[i] in its run
+	for j, k := range b {
+		i, ok := posA[k]
+		if !ok {
+			continue
+		}
+		l := sort.Search(len(tails), func(t int) bool { return posA[b[js[tails[t]]]] >= i })
+		p := -1
+		if l > 0 {
+			p = tails[l-1]
+		}
+		js, prev = append(js, j), append(prev, p)
+		if l == len(tails) {
+			tails = append(tails, len(js)-1)
+		} else {
+			tails[l] = len(js) - 1
+		}
+	}
+	if len(tails) == 0 {
+		return keepA, keepB
+	}
+	for t := tails[len(tails)-1]; t >= 0; t = prev[t] {
+		keepB[js[t]] = true
+		keepA[posA[b[js[t]]]] = true
+	}
+	return keepA, keepB
+}
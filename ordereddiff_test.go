@@ -0,0 +1,127 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func keyedList(keys ...interface{}) *LinkedList {
+	ll := New()
+	for _, k := range keys {
+		ll.Append(map[string]interface{}{"id": k})
+	}
+	return ll
+}
+
+// applyEdits replays an edit script on the keys of a.
+func applyEdits(a []interface{}, edits []Edit) []interface{} {
+	removed := make(map[int]bool)
+	for _, e := range edits {
+		if e.From >= 0 {
+			removed[e.From] = true
+		}
+	}
+	var out []interface{}
+	for i, k := range a {
+		if !removed[i] {
+			out = append(out, k)
+		}
+	}
+	for _, e := range edits {
+		if e.To < 0 {
+			continue
+		}
+		out = append(out, nil)
+		copy(out[e.To+1:], out[e.To:])
+		out[e.To] = e.Key
+	}
+	return out
+}
+
+func TestOrderedDiff(t *testing.T) {
+	a := keyedList("a", "b", "c", "d", "e")
+	b := keyedList("a", "d", "c", "f", "e")
+	edits, err := OrderedDiff(a, b, "id")
+	if err != nil {
+		t.Fatalf("OrderedDiff failed: %v", err)
+	}
+	got := make(map[string]Edit)
+	for _, e := range edits {
+		got[e.Op.String()+":"+e.Key.(string)] = e
+	}
+	if len(edits) != 3 {
+		t.Fatalf("Expected 3 edits, got %v", edits)
+	}
+	if e, ok := got["delete:b"]; !ok || e.From != 1 || e.To != -1 {
+		t.Errorf("Expected b deleted from 1, got %v", edits)
+	}
+	if e, ok := got["insert:f"]; !ok || e.To != 3 || e.Node != b.head.next.next.next {
+		t.Errorf("Expected f inserted at 3, got %v", edits)
+	}
+	_, movedC := got["move:c"]
+	_, movedD := got["move:d"]
+	if movedC == movedD {
+		t.Errorf("Expected exactly one of c and d moved, got %v", edits)
+	}
+	want := []interface{}{"a", "d", "c", "f", "e"}
+	if out := applyEdits(columnValues(a, "id"), edits); !reflect.DeepEqual(out, want) {
+		t.Errorf("Expected script to yield %v, got %v", want, out)
+	}
+}
+
+func TestOrderedDiffRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		var ak, bk []interface{}
+		for i := 0; i < 12; i++ {
+			if rng.Intn(3) > 0 {
+				ak = append(ak, i)
+			}
+			if rng.Intn(3) > 0 {
+				bk = append(bk, i)
+			}
+		}
+		rng.Shuffle(len(bk), func(i, j int) { bk[i], bk[j] = bk[j], bk[i] })
+		edits, err := OrderedDiff(keyedList(ak...), keyedList(bk...), "id")
+		if err != nil {
+			t.Fatalf("OrderedDiff failed: %v", err)
+		}
+		if out := applyEdits(ak, edits); !reflect.DeepEqual(out, bk) && !(len(out) == 0 && len(bk) == 0) {
+			t.Fatalf("%v -> %v: script %v yields %v", ak, bk, edits, out)
+		}
+		if !sort.SliceIsSorted(edits, func(i, j int) bool { return edits[i].Op == EditDelete && edits[j].Op != EditDelete }) {
+			t.Fatalf("Expected deletes first, got %v", edits)
+		}
+	}
+}
+
+func TestOrderedDiffEdgeCases(t *testing.T) {
+	same := keyedList(1, 2, 3)
+	if edits, err := OrderedDiff(same, keyedList(1, 2, 3), "id"); err != nil || len(edits) != 0 {
+		t.Errorf("Expected no edits for equal lists, got %v, %v", edits, err)
+	}
+	edits, err := OrderedDiff(nil, keyedList(1, 2), "id")
+	if err != nil || len(edits) != 2 || edits[0].Op != EditInsert {
+		t.Errorf("Expected 2 inserts from an empty list, got %v, %v", edits, err)
+	}
+	if _, err := OrderedDiff(keyedList(1, 1), keyedList(1), "id"); err == nil {
+		t.Error("Expected error for duplicate keys")
+	}
+}
+
+func TestOrderedDiffReversedLarge(t *testing.T) {
+	const n = 20000
+	fwd, rev := make([]interface{}, n), make([]interface{}, n)
+	for i := range fwd {
+		fwd[i], rev[n-1-i] = i, i
+	}
+	edits, err := OrderedDiff(keyedList(fwd...), keyedList(rev...), "id")
+	if err != nil {
+		t.Fatalf("OrderedDiff failed: %v", err)
+	}
+	if len(edits) != n-1 {
+		t.Errorf("Expected %d moves, got %d", n-1, len(edits))
+	}
+}
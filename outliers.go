@@ -0,0 +1,102 @@
+package linkedlist
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// OutlierMethod selects how Outliers flags values.
+type OutlierMethod int
+
+const (
+	// OutlierIQR flags values more than 1.5 interquartile ranges below the
+	// first or above the third quartile (Tukey's fences).
+	OutlierIQR OutlierMethod = iota
+	// OutlierZScore flags values more than 3 sample standard deviations
+	// from the mean.
+	OutlierZScore
+)
+
+// Outliers returns the rows whose numeric column value is an outlier by
+// method, in list order. NULLs are skipped; other non-numeric values are an
+// error. Like Filter, the result shares Data with ll.
+func (ll *LinkedList) Outliers(column string, method OutlierMethod) (*LinkedList, error) {
+	var nodes []*Node
+	var values []float64
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		v, _ := lookupColumn(n.Data, column)
+		if v == nil {
+			return nil
+		}
+		f, ok := toFloat64(v)
+		if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("row %d: %s value %v is not a finite number", pos, column, v)
+		}
+		nodes = append(nodes, n)
+		values = append(values, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var isOutlier func(float64) bool
+	switch method {
+	case OutlierIQR:
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+		lo, hi := q1-1.5*(q3-q1), q3+1.5*(q3-q1)
+		isOutlier = func(f float64) bool { return f < lo || f > hi }
+	case OutlierZScore:
+		mean, sd := meanStdDev(values)
+		isOutlier = func(f float64) bool { return sd > 0 && math.Abs(f-mean)/sd > 3 }
+	default:
+		return nil, fmt.Errorf("unknown outlier method %d", method)
+	}
+
+	result := New()
+	for i, n := range nodes {
+		if isOutlier(values[i]) {
+			result.appendNode(n.shareCopy())
+		}
+	}
+	ll.recordDerived(result, "Outliers %s", column)
+	return result, nil
+}
+
+// quantile returns the q-quantile of sorted values, interpolating linearly
+// between closest ranks. It returns 0 for no values.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	i := int(pos)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (pos-float64(i))*(sorted[i+1]-sorted[i])
+}
+
+// meanStdDev returns the mean and sample standard deviation of values.
+func meanStdDev(values []float64) (mean, sd float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	for _, f := range values {
+		mean += f
+	}
+	mean /= float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	var ss float64
+	for _, f := range values {
+		ss += (f - mean) * (f - mean)
+	}
+	return mean, math.Sqrt(ss / float64(len(values)-1))
+}
@@ -0,0 +1,57 @@
+package linkedlist
+
+import "testing"
+
+func outlierList(values ...interface{}) *LinkedList {
+	ll := New()
+	for i, v := range values {
+		ll.Append(map[string]interface{}{"id": i + 1, "amount": v})
+	}
+	return ll
+}
+
+func TestOutliersIQR(t *testing.T) {
+	ll := outlierList(10, 12, 11, 13, 12, nil, 11, 100, -50)
+
+	out, err := ll.Outliers("amount", OutlierIQR)
+	if err != nil {
+		t.Fatalf("Outliers failed: %v", err)
+	}
+	if out.Len() != 2 {
+		t.Fatalf("Expected 2 outliers, got %d", out.Len())
+	}
+	if out.First().Data["id"] != 8 || out.Last().Data["id"] != 9 {
+		t.Errorf("Expected rows 8 and 9, got %v and %v", out.First().Data["id"], out.Last().Data["id"])
+	}
+}
+
+func TestOutliersZScore(t *testing.T) {
+	values := make([]interface{}, 0, 21)
+	for i := 0; i < 20; i++ {
+		values = append(values, 50+i%3)
+	}
+	values = append(values, 500)
+	ll := outlierList(values...)
+
+	out, err := ll.Outliers("amount", OutlierZScore)
+	if err != nil {
+		t.Fatalf("Outliers failed: %v", err)
+	}
+	if out.Len() != 1 || out.First().Data["amount"] != 500 {
+		t.Errorf("Expected only 500 flagged, got %d rows", out.Len())
+	}
+
+	flat, _ := outlierList(5, 5, 5).Outliers("amount", OutlierZScore)
+	if flat.Len() != 0 {
+		t.Errorf("Expected no outliers in constant data, got %d", flat.Len())
+	}
+}
+
+func TestOutliersErrors(t *testing.T) {
+	if _, err := outlierList("x").Outliers("amount", OutlierIQR); err == nil {
+		t.Error("Expected error for non-numeric value, got nil")
+	}
+	if _, err := outlierList(1).Outliers("amount", OutlierMethod(9)); err == nil {
+		t.Error("Expected error for unknown method, got nil")
+	}
+}
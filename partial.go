@@ -0,0 +1,37 @@
+package linkedlist
+
+import "fmt"
+
+// PartialLoadError is returned when a load fails after it started reading
+// rows. The rows read before the failure stay in the list unless
+// LoadOptions.RollbackOnError is set.
+type PartialLoadError struct {
+	// Rows is the number of rows appended before the failure.
+	Rows int
+	// RolledBack reports whether those rows were removed again.
+	RolledBack bool
+	Err        error
+}
+
+func (e *PartialLoadError) Error() string {
+	if e.RolledBack {
+		return fmt.Sprintf("load failed after %d rows, rolled back: %v", e.Rows, e.Err)
+	}
+	return fmt.Sprintf("load failed after %d rows: %v", e.Rows, e.Err)
+}
+
+func (e *PartialLoadError) Unwrap() error {
+	return e.Err
+}
+
+// rollbackLoad removes the nodes in loaded from the list. Rows already moved
+// to a spill file stay.
+func (ll *LinkedList) rollbackLoad(loaded map[*Node]bool) {
+	var keep []*Node
+	for n := ll.front(); n != nil; n = n.succ() {
+		if !loaded[n] {
+			keep = append(keep, n)
+		}
+	}
+	ll.relink(keep)
+}
@@ -0,0 +1,98 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+// failingRows returns rows yielding ids 1..n and then err.
+func failingRows(t *testing.T, n int, err error) *sqlx.Rows {
+	t.Helper()
+	db, mock := cacheDB(t)
+	rs := sqlmock.NewRows([]string{"id"})
+	for i := 1; i <= n; i++ {
+		rs.AddRow(int64(i))
+	}
+	rs.AddRow(int64(n+1)).RowError(n, err)
+	mock.ExpectQuery("SELECT").WillReturnRows(rs)
+	rows, qerr := db.Queryx("SELECT id FROM t")
+	if qerr != nil {
+		t.Fatalf("query failed: %v", qerr)
+	}
+	t.Cleanup(func() { rows.Close() })
+	return rows
+}
+
+func TestLoadPartialLoadError(t *testing.T) {
+	boom := errors.New("connection reset")
+	ll := New()
+	ll.Append(map[string]interface{}{"id": int64(0)})
+	err := ll.LoadFromSQLx(failingRows(t, 3, boom))
+
+	var pe *PartialLoadError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PartialLoadError, got %T: %v", err, err)
+	}
+	if pe.Rows != 3 || pe.RolledBack {
+		t.Errorf("Expected 3 rows kept, got %d (rolled back %v)", pe.Rows, pe.RolledBack)
+	}
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected the underlying error to unwrap, got %v", err)
+	}
+	if ll.Len() != 4 {
+		t.Errorf("Expected 4 rows in the list, got %d", ll.Len())
+	}
+}
+
+func TestLoadRollbackOnError(t *testing.T) {
+	boom := errors.New("connection reset")
+	ll := New()
+	ll.Append(map[string]interface{}{"id": int64(0)})
+	err := ll.LoadFromSQLxWithOptions(failingRows(t, 3, boom), LoadOptions{RollbackOnError: true})
+
+	var pe *PartialLoadError
+	if !errors.As(err, &pe) || !pe.RolledBack || pe.Rows != 3 {
+		t.Fatalf("Expected rolled back PartialLoadError after 3 rows, got %v", err)
+	}
+	if ll.Len() != 1 || ll.head.Data["id"] != int64(0) {
+		t.Errorf("Expected only the original row to remain, got %d rows", ll.Len())
+	}
+	ll.Append(map[string]interface{}{"id": int64(9)})
+	if ll.Len() != 2 || ll.tail.Data["id"] != int64(9) {
+		t.Errorf("Expected the list to stay usable after rollback, got %d rows", ll.Len())
+	}
+}
+
+func TestLoadSuccessIsNotPartial(t *testing.T) {
+	ll := New()
+	if err := ll.LoadFromSQLxWithOptions(queryRows(t, []string{"id"}, []interface{}{int64(1)}), LoadOptions{RollbackOnError: true}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if ll.Len() != 1 {
+		t.Errorf("Expected 1 row, got %d", ll.Len())
+	}
+}
+
+func TestLoadSetupErrorIsNotPartial(t *testing.T) {
+	rows := queryRows(t, []string{"id"}, []interface{}{int64(1)})
+	err := New().LoadFromSQLxWithOptions(rows, LoadOptions{Transformers: []string{"no-such-transformer"}})
+	var pe *PartialLoadError
+	if err == nil || errors.As(err, &pe) {
+		t.Errorf("Expected a plain setup error, got %T: %v", err, err)
+	}
+}
+
+func TestLoadRollbackRefusedOnBoundedLists(t *testing.T) {
+	for _, ll := range []*LinkedList{New(WithMaxLen(2, nil)), New(WithSpill(t.TempDir(), 2))} {
+		rows := queryRows(t, []string{"id"}, []interface{}{int64(1)})
+		if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{RollbackOnError: true}); err == nil {
+			t.Error("Expected RollbackOnError to be refused")
+		}
+		if ll.Len() != 0 {
+			t.Errorf("Expected no rows loaded, got %d", ll.Len())
+		}
+	}
+}
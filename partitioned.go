@@ -0,0 +1,215 @@
+package linkedlist
+
+import (
+	"sync"
+)
+
+// PartitionedList keeps a separate LinkedList per value of a tenant column,
+// for multi-tenant caches. Its methods are safe to call from several
+// goroutines: each partition has a lock that Append, Len, Merge and the
+// ForEach callbacks hold while they use it. A list returned by PerTenant is
+// not covered by that lock and needs its own synchronization if shared.
+type PartitionedList struct {
+	column  string
+	maxRows int
+	opts    []Option
+	mu      sync.RWMutex
+	parts   map[string]*tenantPart
+	ids     map[string]interface{}
+	order   []string
+}
+
+// tenantPart is one partition and the lock serializing access to it.
+type tenantPart struct {
+	mu sync.Mutex
+	ll *LinkedList
+}
+
+// NewPartitioned returns an empty PartitionedList keyed by column. Each
+// partition is created with opts and, when maxPerTenant is positive, holds
+// at most maxPerTenant rows, evicting its oldest as WithMaxLen does.
+func NewPartitioned(column string, maxPerTenant int, opts ...Option) *PartitionedList {
+	return &PartitionedList{
+		column:  column,
+		maxRows: maxPerTenant,
+		opts:    opts,
+		parts:   make(map[string]*tenantPart),
+		ids:     make(map[string]interface{}),
+	}
+}
+
+// Partition splits the list's rows, spilled ones included, into a
+// PartitionedList by column. The partitions share Data with ll until either
+// side changes it. Read errors are reported by ll's SpillErr.
+func (ll *LinkedList) Partition(column string, maxPerTenant int, opts ...Option) *PartitionedList {
+	p := NewPartitioned(column, maxPerTenant, opts...)
+	ll.each(func(n *Node) error {
+		p.partition(n.Data[column], true).ll.appendNode(n.shareCopy())
+		return nil
+	})
+	return p
+}
+
+// partition returns the partition of tenant id, creating it if create is
+// set.
+func (p *PartitionedList) partition(id interface{}, create bool) *tenantPart {
+	k := EncodeKey(id)
+	p.mu.RLock()
+	part := p.parts[k]
+	p.mu.RUnlock()
+	if part != nil || !create {
+		return part
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if part = p.parts[k]; part == nil {
+		ll := New(p.opts...)
+		if p.maxRows > 0 {
+			ll.maxLen = p.maxRows
+		}
+		part = &tenantPart{ll: ll}
+		p.parts[k] = part
+		p.ids[k] = id
+		p.order = append(p.order, k)
+	}
+	return part
+}
+
+// Append adds a row to the partition of its tenant column.
+func (p *PartitionedList) Append(data map[string]interface{}) {
+	part := p.partition(data[p.column], true)
+	part.mu.Lock()
+	defer part.mu.Unlock()
+	part.ll.Append(data)
+}
+
+// PerTenant returns the partition of tenant id, or nil if it has no rows.
+// Numbers match regardless of Go type. Reading it while other goroutines
+// Append to the same tenant is a race; use ForEach for that.
+func (p *PartitionedList) PerTenant(id interface{}) *LinkedList {
+	if part := p.partition(id, false); part != nil {
+		return part.ll
+	}
+	return nil
+}
+
+// Tenants returns the tenant ids in the order their partitions were created.
+func (p *PartitionedList) Tenants() []interface{} {
+	ids, _ := p.snapshot()
+	return ids
+}
+
+// Drop removes the partition of tenant id.
+func (p *PartitionedList) Drop(id interface{}) {
+	k := EncodeKey(id)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.parts[k]; !ok {
+		return
+	}
+	delete(p.parts, k)
+	delete(p.ids, k)
+	for i, o := range p.order {
+		if o == k {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Len returns the number of rows in all partitions.
+func (p *PartitionedList) Len() int {
+	total := 0
+	_, parts := p.snapshot()
+	for _, part := range parts {
+		part.mu.Lock()
+		total += part.ll.Len()
+		part.mu.Unlock()
+	}
+	return total
+}
+
+// ForEach calls fn for every partition in creation order, stopping at the
+// first error. fn holds the partition's lock, so it must not Append to the
+// PartitionedList itself.
+func (p *PartitionedList) ForEach(fn func(id interface{}, ll *LinkedList) error) error {
+	ids, parts := p.snapshot()
+	for i, part := range parts {
+		if err := part.with(ids[i], fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachParallel is ForEach with up to workers partitions processed at
+// once. Each partition is handled by one goroutine at a time, under its
+// lock as in ForEach. It returns
+// the error of the earliest partition that failed; partitions already
+// started still finish.
+func (p *PartitionedList) ForEachParallel(workers int, fn func(id interface{}, ll *LinkedList) error) error {
+	ids, parts := p.snapshot()
+	if workers <= 0 || workers > len(parts) {
+		workers = len(parts)
+	}
+	errs := make([]error, len(parts))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = parts[i].with(ids[i], fn)
+			}
+		}()
+	}
+	for i := range parts {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge returns a new list with the rows of every partition, partition by
+// partition in creation order. It shares Data with the partitions until
+// either side changes it.
+func (p *PartitionedList) Merge() *LinkedList {
+	result := New()
+	_, parts := p.snapshot()
+	for _, part := range parts {
+		part.mu.Lock()
+		part.ll.each(func(n *Node) error {
+			result.appendNode(n.shareCopy())
+			return nil
+		})
+		part.mu.Unlock()
+	}
+	return result
+}
+
+// with calls fn on the partition while holding its lock.
+func (part *tenantPart) with(id interface{}, fn func(id interface{}, ll *LinkedList) error) error {
+	part.mu.Lock()
+	defer part.mu.Unlock()
+	return fn(id, part.ll)
+}
+
+// snapshot returns the tenant ids and partitions in creation order.
+func (p *PartitionedList) snapshot() ([]interface{}, []*tenantPart) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	ids := make([]interface{}, len(p.order))
+	parts := make([]*tenantPart, len(p.order))
+	for i, k := range p.order {
+		ids[i], parts[i] = p.ids[k], p.parts[k]
+	}
+	return ids, parts
+}
@@ -0,0 +1,125 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPartitionedList(t *testing.T) {
+	p := NewPartitioned("tenant_id", 2)
+	for i, tenant := range []interface{}{1, 2, int64(1), 1, 3} {
+		p.Append(map[string]interface{}{"tenant_id": tenant, "id": i})
+	}
+	if got := p.Tenants(); !reflect.DeepEqual(got, []interface{}{1, 2, 3}) {
+		t.Errorf("Expected tenants [1 2 3], got %v", got)
+	}
+	one := p.PerTenant(int64(1))
+	if got := columnValues(one, "id"); !reflect.DeepEqual(got, []interface{}{2, 3}) {
+		t.Errorf("Expected tenant 1 capped to ids [2 3], got %v", got)
+	}
+	if p.PerTenant(9) != nil {
+		t.Error("Expected nil for an unknown tenant")
+	}
+	if p.Len() != 4 {
+		t.Errorf("Expected 4 rows, got %d", p.Len())
+	}
+	if got := columnValues(p.Merge(), "id"); !reflect.DeepEqual(got, []interface{}{2, 3, 1, 4}) {
+		t.Errorf("Expected merged ids [2 3 1 4], got %v", got)
+	}
+
+	p.Drop(2)
+	if got := p.Tenants(); !reflect.DeepEqual(got, []interface{}{1, 3}) {
+		t.Errorf("Expected tenants [1 3] after Drop, got %v", got)
+	}
+}
+
+func TestPartitionFromList(t *testing.T) {
+	p := tenantRows().Partition("tenant_id", 0)
+	if len(p.Tenants()) != 2 || p.PerTenant(1).Len() != 3 {
+		t.Errorf("Expected 2 tenants with 3 rows for tenant 1, got %v", p.Tenants())
+	}
+}
+
+func TestPartitionedForEach(t *testing.T) {
+	p := tenantRows().Partition("tenant_id", 0)
+	var mu sync.Mutex
+	counts := make(map[interface{}]int)
+	err := p.ForEachParallel(4, func(id interface{}, ll *LinkedList) error {
+		mu.Lock()
+		defer mu.Unlock()
+		counts[id] = ll.Len()
+		return ll.AddColumn("seen", true)
+	})
+	if err != nil {
+		t.Fatalf("ForEachParallel failed: %v", err)
+	}
+	if !reflect.DeepEqual(counts, map[interface{}]int{1: 3, 2: 1}) {
+		t.Errorf("Expected counts 3 and 1, got %v", counts)
+	}
+	if got := columnValues(p.Merge(), "seen"); len(got) != 4 || got[3] != true {
+		t.Errorf("Expected every row marked, got %v", got)
+	}
+
+	boom := errors.New("boom")
+	calls := 0
+	err = p.ForEach(func(interface{}, *LinkedList) error {
+		calls++
+		return boom
+	})
+	if !errors.Is(err, boom) || calls != 1 {
+		t.Errorf("Expected ForEach to stop at the first error, got %v after %d calls", err, calls)
+	}
+	if err := p.ForEachParallel(0, func(interface{}, *LinkedList) error { return boom }); !errors.Is(err, boom) {
+		t.Errorf("Expected ForEachParallel to report errors, got %v", err)
+	}
+}
+
+func TestPartitionedConcurrentAppend(t *testing.T) {
+	p := NewPartitioned("tenant_id", 0)
+	var wg sync.WaitGroup
+	for tenant := 0; tenant < 8; tenant++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				p.Append(map[string]interface{}{"tenant_id": tenant, "id": i})
+			}
+		}()
+	}
+	wg.Wait()
+	if p.Len() != 400 || len(p.Tenants()) != 8 {
+		t.Errorf("Expected 400 rows in 8 partitions, got %d in %d", p.Len(), len(p.Tenants()))
+	}
+}
+
+func TestPartitionedConcurrentAppendSameTenant(t *testing.T) {
+	p := NewPartitioned("tenant_id", 0)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				p.Append(map[string]interface{}{"tenant_id": 1, "id": i})
+				p.Len()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			p.ForEach(func(_ interface{}, ll *LinkedList) error {
+				ll.Len()
+				return nil
+			})
+			p.Merge()
+		}
+	}()
+	wg.Wait()
+	if p.Len() != 400 || p.PerTenant(1).Len() != 400 {
+		t.Errorf("Expected 400 rows for tenant 1, got %d", p.Len())
+	}
+}
@@ -0,0 +1,242 @@
+package linkedlist
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParsePGArray parses a Postgres array literal such as {1,2,3} or
+// {{"a b",NULL},{c,d}} into a []interface{} of strings, nil for NULL
+// elements and nested slices for multi-dimensional arrays.
+func ParsePGArray(s string) ([]interface{}, error) {
+	p := pgArrayParser{s: strings.TrimSpace(s)}
+	// Arrays with explicit bounds start with a dimension decoration
+	if strings.HasPrefix(p.s, "[") {
+		i := strings.Index(p.s, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid array literal %q", s)
+		}
+		p.s = p.s[i+1:]
+	}
+	elems, err := p.array()
+	if err != nil {
+		return nil, fmt.Errorf("invalid array literal %q: %w", s, err)
+	}
+	if p.pos != len(p.s) {
+		return nil, fmt.Errorf("invalid array literal %q: trailing data", s)
+	}
+	return elems, nil
+}
+
+// pgArrayParser reads an array literal left to right.
+type pgArrayParser struct {
+	s   string
+	pos int
+}
+
+func (p *pgArrayParser) array() ([]interface{}, error) {
+	if p.pos >= len(p.s) || p.s[p.pos] != '{' {
+		return nil, fmt.Errorf("expected '{' at %d", p.pos)
+	}
+	p.pos++
+	elems := []interface{}{}
+	if p.pos < len(p.s) && p.s[p.pos] == '}' {
+		p.pos++
+		return elems, nil
+	}
+	for {
+		if p.pos >= len(p.s) {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		var elem interface{}
+		switch p.s[p.pos] {
+		case '{':
+			sub, err := p.array()
+			if err != nil {
+				return nil, err
+			}
+			elem = sub
+		case '"':
+			str, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			elem = str
+		default:
+			start := p.pos
+			for p.pos < len(p.s) && p.s[p.pos] != ',' && p.s[p.pos] != '}' {
+				p.pos++
+			}
+			str := strings.TrimSpace(p.s[start:p.pos])
+			if !strings.EqualFold(str, "NULL") {
+				elem = str
+			}
+		}
+		elems = append(elems, elem)
+		if p.pos >= len(p.s) {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return elems, nil
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d", p.s[p.pos], p.pos)
+		}
+	}
+}
+
+func (p *pgArrayParser) quoted() (string, error) {
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.s); p.pos++ {
+		switch c := p.s[p.pos]; c {
+		case '\\':
+			p.pos++
+			if p.pos < len(p.s) {
+				b.WriteByte(p.s[p.pos])
+			}
+		case '"':
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated quoted element")
+}
+
+// arrayColumns returns the columns to parse as arrays on load, mapped to
+// their database element type name: those in names and those whose
+// database type, as lib/pq and pgx report it, is an array such as _INT4.
+func arrayColumns(names []string, types []*sql.ColumnType, loaded []string) map[string]string {
+	cols := make(map[string]string)
+	for _, n := range names {
+		cols[n] = ""
+	}
+	for i, ct := range types {
+		if elem, ok := strings.CutPrefix(ct.DatabaseTypeName(), "_"); ok {
+			cols[loadedName(ct, i, loaded)] = elem
+		}
+	}
+	return cols
+}
+
+// parseArrayColumns replaces array literals in the given columns of a row
+// with slices. Elements of integer, float and boolean arrays are typed;
+// others stay strings.
+func parseArrayColumns(data map[string]interface{}, cols map[string]string) error {
+	for col, elemType := range cols {
+		s, ok := data[col].(string)
+		if !ok {
+			continue
+		}
+		elems, err := ParsePGArray(s)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", col, err)
+		}
+		if elems, err = typeArrayElems(elems, pgElemKind(elemType)); err != nil {
+			return fmt.Errorf("column %s: %w", col, err)
+		}
+		data[col] = elems
+	}
+	return nil
+}
+
+// pgElemKind maps a Postgres element type name to the Go kind its values
+// are parsed as.
+func pgElemKind(name string) reflect.Kind {
+	switch strings.ToUpper(name) {
+	case "INT2", "INT4", "INT8":
+		return reflect.Int64
+	case "FLOAT4", "FLOAT8":
+		return reflect.Float64
+	case "BOOL":
+		return reflect.Bool
+	}
+	return reflect.String
+}
+
+// typeArrayElems returns a copy of a parsed array with its string
+// elements, at any depth, parsed as values of kind.
+func typeArrayElems(elems []interface{}, kind reflect.Kind) ([]interface{}, error) {
+	typed := make([]interface{}, len(elems))
+	for i, e := range elems {
+		switch x := e.(type) {
+		case []interface{}:
+			sub, err := typeArrayElems(x, kind)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = sub
+		case string:
+			v, err := parseArrayElem(x, kind)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = v
+		default:
+			typed[i] = e
+		}
+	}
+	return typed, nil
+}
+
+// parseArrayElem parses one array element as kind.
+func parseArrayElem(s string, kind reflect.Kind) (interface{}, error) {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.ParseInt(s, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseUint(s, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(s, 64)
+	case reflect.Bool:
+		switch s {
+		case "t", "true":
+			return true, nil
+		case "f", "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid boolean %q", s)
+	}
+	return s, nil
+}
+
+// arrayForSlice turns an array literal, or a parsed array of strings, into
+// elements fit for a slice of fieldType. It reports false when dataValue is
+// neither.
+func arrayForSlice(fieldType reflect.Type, dataValue interface{}) ([]interface{}, bool, error) {
+	elemType := fieldType.Elem()
+	if elemType.Kind() == reflect.Uint8 {
+		return nil, false, nil
+	}
+	var elems []interface{}
+	switch x := dataValue.(type) {
+	case string:
+		if !strings.HasPrefix(strings.TrimSpace(x), "{") {
+			return nil, false, nil
+		}
+		parsed, err := ParsePGArray(x)
+		if err != nil {
+			return nil, true, err
+		}
+		elems = parsed
+	case []interface{}:
+		elems = x
+	default:
+		return nil, false, nil
+	}
+	if k := elemType.Kind(); k != reflect.String && k != reflect.Slice && k != reflect.Interface {
+		typed, err := typeArrayElems(elems, k)
+		if err != nil {
+			return nil, true, err
+		}
+		elems = typed
+	}
+	return elems, true, nil
+}
@@ -0,0 +1,98 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestParsePGArray(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []interface{}
+	}{
+		{"{}", []interface{}{}},
+		{"{1,2,3}", []interface{}{"1", "2", "3"}},
+		{`{"a b",NULL,"q\"uote",c}`, []interface{}{"a b", nil, `q"uote`, "c"}},
+		{"{{1,2},{3,4}}", []interface{}{[]interface{}{"1", "2"}, []interface{}{"3", "4"}}},
+		{"[0:1]={x,y}", []interface{}{"x", "y"}},
+	}
+	for _, c := range cases {
+		got, err := ParsePGArray(c.in)
+		if err != nil {
+			t.Errorf("ParsePGArray(%q) failed: %v", c.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Expected %#v for %q, got %#v", c.want, c.in, got)
+		}
+	}
+
+	for _, bad := range []string{"", "{1,2", `{"a}`, "{1}x"} {
+		if _, err := ParsePGArray(bad); err == nil {
+			t.Errorf("Expected error for %q, got nil", bad)
+		}
+	}
+}
+
+func TestScanArrayLiteralIntoSlices(t *testing.T) {
+	type row struct {
+		IDs  []int     `db:"ids"`
+		Tags []string  `db:"tags"`
+		Grid [][]int64 `db:"grid"`
+	}
+	ll := New()
+	ll.Append(map[string]interface{}{
+		"ids":  "{1,2,3}",
+		"tags": `{red,"dark blue"}`,
+		"grid": "{{1,2},{3,4}}",
+	})
+
+	var dest row
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if !reflect.DeepEqual(dest.IDs, []int{1, 2, 3}) {
+		t.Errorf("Expected ids [1 2 3], got %v", dest.IDs)
+	}
+	if !reflect.DeepEqual(dest.Tags, []string{"red", "dark blue"}) {
+		t.Errorf("Expected tags [red dark blue], got %v", dest.Tags)
+	}
+	if !reflect.DeepEqual(dest.Grid, [][]int64{{1, 2}, {3, 4}}) {
+		t.Errorf("Expected grid [[1 2] [3 4]], got %v", dest.Grid)
+	}
+}
+
+func TestLoadArrayColumns(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	defer sqlDB.Close()
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+
+	rows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("scores").OfType("_INT4", nil),
+		sqlmock.NewColumn("labels").OfType("TEXT", nil),
+	).AddRow("{10,NULL,30}", "{a,b}")
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+	sqlxRows, err := db.Queryx("SELECT")
+	if err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	defer sqlxRows.Close()
+
+	ll := New()
+	if err := ll.LoadFromSQLxWithOptions(sqlxRows, LoadOptions{ArrayColumns: []string{"labels"}}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	data := ll.First().Data
+	if want := []interface{}{int64(10), nil, int64(30)}; !reflect.DeepEqual(data["scores"], want) {
+		t.Errorf("Expected scores %v, got %#v", want, data["scores"])
+	}
+	if want := []interface{}{"a", "b"}; !reflect.DeepEqual(data["labels"], want) {
+		t.Errorf("Expected labels %v, got %#v", want, data["labels"])
+	}
+}
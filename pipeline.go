@@ -0,0 +1,228 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"sync"
+)
+
+// defaultPipelineBuffer is the capacity of the channels between pipeline
+// stages unless Buffer sets another.
+const defaultPipelineBuffer = 64
+
+// pipelineStage transforms one row, reporting false to drop it.
+type pipelineStage func(row map[string]interface{}) (map[string]interface{}, bool, error)
+
+// SinkFunc receives the rows leaving a pipeline, a batch at a time.
+type SinkFunc func(ctx context.Context, batch []map[string]interface{}) error
+
+// Pipeline streams rows from a RowSource through filter and map stages into
+// a sink. Each stage runs in its own goroutine connected by bounded
+// channels, so a slow sink holds back the source instead of rows piling up
+// in memory. Nothing runs until Run.
+type Pipeline struct {
+	source RowSource
+	stages []pipelineStage
+	batch  int
+	buffer int
+	sink   SinkFunc
+}
+
+// NewPipeline starts a pipeline reading from source.
+func NewPipeline(source RowSource) *Pipeline {
+	return &Pipeline{source: source, batch: 1, buffer: defaultPipelineBuffer}
+}
+
+// Filter keeps only the rows for which keep returns true.
+func (p *Pipeline) Filter(keep func(row map[string]interface{}) bool) *Pipeline {
+	p.stages = append(p.stages, func(row map[string]interface{}) (map[string]interface{}, bool, error) {
+		return row, keep(row), nil
+	})
+	return p
+}
+
+// Map replaces each row with the result of fn. An error stops the pipeline.
+func (p *Pipeline) Map(fn func(row map[string]interface{}) (map[string]interface{}, error)) *Pipeline {
+	p.stages = append(p.stages, func(row map[string]interface{}) (map[string]interface{}, bool, error) {
+		out, err := fn(row)
+		return out, err == nil, err
+	})
+	return p
+}
+
+// Batch sets how many rows the sink receives per call; the last batch may
+// be shorter. The default is 1.
+func (p *Pipeline) Batch(size int) *Pipeline {
+	if size > 0 {
+		p.batch = size
+	}
+	return p
+}
+
+// Buffer sets how many rows may wait between two stages.
+func (p *Pipeline) Buffer(size int) *Pipeline {
+	if size >= 0 {
+		p.buffer = size
+	}
+	return p
+}
+
+// Sink sets where the rows go.
+func (p *Pipeline) Sink(fn SinkFunc) *Pipeline {
+	p.sink = fn
+	return p
+}
+
+// Run executes the pipeline until the source is exhausted. The first error
+// from the source, a stage or the sink cancels the rest of the pipeline and
+// is returned, as is ctx's error if it is cancelled first.
+func (p *Pipeline) Run(ctx context.Context) error {
+	if p.source == nil {
+		return errors.New("pipeline has no source")
+	}
+	if p.sink == nil {
+		return errors.New("pipeline has no sink")
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	src := make(chan map[string]interface{}, p.buffer)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(src)
+		for {
+			row, err := p.source.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				fail(err)
+				return
+			}
+			select {
+			case src <- row:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	in := (<-chan map[string]interface{})(src)
+	for _, stage := range p.stages {
+		out := make(chan map[string]interface{}, p.buffer)
+		wg.Add(1)
+		go func(in <-chan map[string]interface{}) {
+			defer wg.Done()
+			defer close(out)
+			for row := range in {
+				row, keep, err := stage(row)
+				if err != nil {
+					fail(err)
+					return
+				}
+				if !keep {
+					continue
+				}
+				select {
+				case out <- row:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(in)
+		in = out
+	}
+
+	batch := make([]map[string]interface{}, 0, p.batch)
+	for row := range in {
+		if ctx.Err() != nil {
+			continue
+		}
+		batch = append(batch, row)
+		if len(batch) == p.batch {
+			if err := p.sink(ctx, batch); err != nil {
+				fail(err)
+			}
+			batch = make([]map[string]interface{}, 0, p.batch)
+		}
+	}
+	if len(batch) > 0 && ctx.Err() == nil {
+		if err := p.sink(ctx, batch); err != nil {
+			fail(err)
+		}
+	}
+	cancel()
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return parent.Err()
+}
+
+// listSource yields copies of a list's rows.
+type listSource struct {
+	ll    *LinkedList
+	nodes []*Node
+	read  bool
+}
+
+// ListSource returns a RowSource yielding a copy of each of the list's rows,
+// so pipeline stages may modify them freely.
+func ListSource(ll *LinkedList) RowSource {
+	return &listSource{ll: ll}
+}
+
+func (s *listSource) NextRow(ctx context.Context) (map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !s.read {
+		s.read = true
+		err := s.ll.each(func(n *Node) error {
+			s.nodes = append(s.nodes, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(s.nodes) == 0 {
+		return nil, io.EOF
+	}
+	n := s.nodes[0]
+	s.nodes = s.nodes[1:]
+	return maps.Clone(n.Data), nil
+}
+
+// AppendSink returns a SinkFunc appending every row to ll. A frozen ll
+// fails with ErrFrozen.
+func AppendSink(ll *LinkedList) SinkFunc {
+	return func(_ context.Context, batch []map[string]interface{}) error {
+		if ll == nil {
+			return nil
+		}
+		if err := ll.beginMutation(); err != nil {
+			return err
+		}
+		for _, row := range batch {
+			ll.Append(row)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func numberedList(n int) *LinkedList {
+	ll := New()
+	for i := 1; i <= n; i++ {
+		ll.Append(map[string]interface{}{"id": i})
+	}
+	return ll
+}
+
+func TestPipelineFilterMapBatch(t *testing.T) {
+	src := numberedList(10)
+	var sizes []int
+	out := New()
+	sink := AppendSink(out)
+	err := NewPipeline(ListSource(src)).
+		Filter(func(row map[string]interface{}) bool { return row["id"].(int)%2 == 0 }).
+		Map(func(row map[string]interface{}) (map[string]interface{}, error) {
+			row["id"] = row["id"].(int) * 10
+			return row, nil
+		}).
+		Batch(2).
+		Sink(func(ctx context.Context, batch []map[string]interface{}) error {
+			sizes = append(sizes, len(batch))
+			return sink(ctx, batch)
+		}).
+		Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	want := []interface{}{20, 40, 60, 80, 100}
+	if got := columnValues(out, "id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if !reflect.DeepEqual(sizes, []int{2, 2, 1}) {
+		t.Errorf("Expected batch sizes [2 2 1], got %v", sizes)
+	}
+	if got := src.head.Data["id"]; got != 1 {
+		t.Errorf("Expected the source list to be unchanged, got id %v", got)
+	}
+}
+
+func TestPipelineIsLazy(t *testing.T) {
+	var mapped int32
+	p := NewPipeline(ListSource(numberedList(3))).
+		Map(func(row map[string]interface{}) (map[string]interface{}, error) {
+			atomic.AddInt32(&mapped, 1)
+			return row, nil
+		}).
+		Sink(func(context.Context, []map[string]interface{}) error { return nil })
+	if mapped != 0 {
+		t.Fatalf("Expected no work before Run, got %d rows mapped", mapped)
+	}
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if mapped != 3 {
+		t.Errorf("Expected 3 rows mapped, got %d", mapped)
+	}
+}
+
+func TestPipelineBackpressure(t *testing.T) {
+	var read int32
+	src := &countingSource{limit: 1000, read: &read}
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- NewPipeline(src).Buffer(2).
+			Sink(func(context.Context, []map[string]interface{}) error {
+				<-release
+				return nil
+			}).
+			Run(context.Background())
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if n := atomic.LoadInt32(&read); n > 10 {
+		t.Errorf("Expected a blocked sink to hold back the source, read %d rows", n)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if read != 1000 {
+		t.Errorf("Expected 1000 rows read, got %d", read)
+	}
+}
+
+func TestPipelineErrors(t *testing.T) {
+	boom := errors.New("bad row")
+	err := NewPipeline(ListSource(numberedList(100))).
+		Map(func(row map[string]interface{}) (map[string]interface{}, error) {
+			if row["id"] == 5 {
+				return nil, boom
+			}
+			return row, nil
+		}).
+		Sink(func(context.Context, []map[string]interface{}) error { return nil }).
+		Run(context.Background())
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected map error, got %v", err)
+	}
+
+	var calls int
+	err = NewPipeline(ListSource(numberedList(100))).
+		Sink(func(context.Context, []map[string]interface{}) error {
+			calls++
+			return boom
+		}).
+		Run(context.Background())
+	if !errors.Is(err, boom) || calls != 1 {
+		t.Errorf("Expected sink error after 1 call, got %v after %d", err, calls)
+	}
+
+	if err := NewPipeline(ListSource(New())).Run(context.Background()); err == nil {
+		t.Error("Expected error for pipeline without sink")
+	}
+	frozen := numberedList(1).Freeze()
+	err = NewPipeline(ListSource(numberedList(1))).Sink(AppendSink(frozen)).Run(context.Background())
+	if !errors.Is(err, ErrFrozen) {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var read int32
+	err := NewPipeline(&countingSource{limit: 1 << 30, read: &read}).
+		Sink(func(context.Context, []map[string]interface{}) error {
+			if atomic.LoadInt32(&read) > 50 {
+				cancel()
+			}
+			return nil
+		}).
+		Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// countingSource yields limit rows, counting how many were read.
+type countingSource struct {
+	limit int32
+	read  *int32
+}
+
+func (s *countingSource) NextRow(ctx context.Context) (map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	n := atomic.AddInt32(s.read, 1)
+	if n > s.limit {
+		atomic.AddInt32(s.read, -1)
+		return nil, io.EOF
+	}
+	return map[string]interface{}{"id": n}, nil
+}
@@ -0,0 +1,87 @@
+package linkedlist
+
+import "sort"
+
+// PriorityList is a work queue over rows that always yields the smallest
+// row first. Rows are kept insertion-sorted, so rows that compare equal
+// come out in the order they were pushed.
+type PriorityList struct {
+	ll   *LinkedList
+	less func(a, b *Node) bool
+}
+
+// NewPriorityList returns an empty PriorityList ordered by less.
+func NewPriorityList(less func(a, b *Node) bool) *PriorityList {
+	return &PriorityList{ll: New(), less: less}
+}
+
+// PriorityList returns a PriorityList holding the list's rows ordered by
+// less, spilled ones included. The rows share Data with ll until either side
+// changes them. Read errors are reported by ll's SpillErr.
+func (ll *LinkedList) PriorityList(less func(a, b *Node) bool) *PriorityList {
+	var nodes []*Node
+	ll.each(func(n *Node) error {
+		nodes = append(nodes, n.shareCopy())
+		return nil
+	})
+	sort.SliceStable(nodes, func(i, j int) bool { return less(nodes[i], nodes[j]) })
+	p := NewPriorityList(less)
+	p.ll.relink(nodes)
+	return p
+}
+
+// PushPriority adds a row in priority order.
+func (p *PriorityList) PushPriority(data map[string]interface{}) {
+	n := &Node{Data: data}
+	ll := p.ll
+	if ll.clock != nil {
+		n.timestamp = ll.clock.Now()
+	}
+	if ll.head == nil || !p.less(n, ll.tail) {
+		ll.appendNode(n)
+		return
+	}
+	ll.mustMutate()
+
+	var prev *Node
+	for cur := ll.front(); cur != nil && !p.less(n, cur); cur = cur.succ() {
+		prev = cur
+	}
+	if prev == nil {
+		n.next = ll.head
+		ll.head = n
+	} else {
+		n.next = prev.next
+		prev.next = n
+	}
+	ll.len++
+	ll.mods++
+	ll.noteAppend(n)
+}
+
+// PopMin removes and returns the smallest row, or nil when empty.
+func (p *PriorityList) PopMin() *Node {
+	if p.ll.head == nil {
+		return nil
+	}
+	p.ll.mustMutate()
+	n := p.ll.front()
+	p.ll.unlink(nil, n)
+	p.ll.mods++
+	return n
+}
+
+// PeekMin returns the smallest row without removing it, or nil when empty.
+func (p *PriorityList) PeekMin() *Node {
+	return p.ll.front()
+}
+
+// Len returns the number of queued rows.
+func (p *PriorityList) Len() int {
+	return p.ll.Len()
+}
+
+// List returns a frozen view of the queued rows in priority order.
+func (p *PriorityList) List() *LinkedList {
+	return p.ll.Freeze()
+}
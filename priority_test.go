@@ -0,0 +1,63 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func byPriority(a, b *Node) bool {
+	return a.Data["prio"].(int) < b.Data["prio"].(int)
+}
+
+func TestPriorityListPushPop(t *testing.T) {
+	p := NewPriorityList(byPriority)
+	if p.PopMin() != nil || p.PeekMin() != nil {
+		t.Fatal("Expected nil from an empty PriorityList")
+	}
+	for i, prio := range []int{5, 1, 3, 1, 9, 0} {
+		p.PushPriority(map[string]interface{}{"id": i, "prio": prio})
+	}
+	if p.Len() != 6 {
+		t.Errorf("Expected 6 rows, got %d", p.Len())
+	}
+	if p.PeekMin().Data["id"] != 5 {
+		t.Errorf("Expected id 5 at the front, got %v", p.PeekMin().Data)
+	}
+
+	var ids []interface{}
+	for n := p.PopMin(); n != nil; n = p.PopMin() {
+		ids = append(ids, n.Data["id"])
+	}
+	want := []interface{}{5, 1, 3, 2, 0, 4}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("Expected %v, got %v", want, ids)
+	}
+	if p.Len() != 0 {
+		t.Errorf("Expected empty list, got %d", p.Len())
+	}
+}
+
+func TestPriorityListFromList(t *testing.T) {
+	ll := New()
+	for i, prio := range []int{2, 1, 2, 0} {
+		ll.Append(map[string]interface{}{"id": i, "prio": prio})
+	}
+	p := ll.PriorityList(byPriority)
+	p.PushPriority(map[string]interface{}{"id": 9, "prio": 1})
+
+	want := []interface{}{3, 1, 9, 0, 2}
+	view := p.List()
+	if got := columnValues(view, "id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if p.PopMin().Data["id"] != 3 || ll.Len() != 4 {
+		t.Errorf("Expected popping to leave the source list alone, got %d rows", ll.Len())
+	}
+	p.PushPriority(map[string]interface{}{"id": 8, "prio": 1})
+	if got := columnValues(view, "id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the view to keep %v, got %v", want, got)
+	}
+	if err := view.AddColumn("x", 1); err != ErrFrozen {
+		t.Errorf("Expected List to be frozen, got %v", err)
+	}
+}
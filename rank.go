@@ -0,0 +1,83 @@
+package linkedlist
+
+import "sort"
+
+// Rank writes into each row a "rank" column (int64) holding its position by
+// orderBy, a specification like "score DESC, name" as accepted by OrderBy,
+// within the rows sharing its partitionBy values. Tied rows share a rank
+// and leave a gap after them, like SQL RANK(). The list's order is
+// unchanged. On error no row is modified.
+func (ll *LinkedList) Rank(orderBy string, partitionBy ...string) error {
+	return ll.rank("rank", false, orderBy, partitionBy)
+}
+
+// DenseRank is Rank without gaps after ties, like SQL DENSE_RANK(),
+// writing a "dense_rank" column.
+func (ll *LinkedList) DenseRank(orderBy string, partitionBy ...string) error {
+	return ll.rank("dense_rank", true, orderBy, partitionBy)
+}
+
+func (ll *LinkedList) rank(column string, dense bool, orderBy string, partitionBy []string) error {
+	keys, err := parseOrderBy(orderBy)
+	if err != nil {
+		return err
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	partitions := make(map[string][]*Node)
+	var order []string
+	for n := ll.front(); n != nil; n = n.succ() {
+		values := make([]interface{}, len(partitionBy))
+		for i, c := range partitionBy {
+			values[i], _ = lookupColumn(n.Data, c)
+		}
+		k := encodeKey(values)
+		if _, ok := partitions[k]; !ok {
+			order = append(order, k)
+		}
+		partitions[k] = append(partitions[k], n)
+	}
+
+	ranks := make(map[*Node]int64, ll.len)
+	for _, k := range order {
+		nodes := partitions[k]
+		var cmpErr error
+		sort.SliceStable(nodes, func(i, j int) bool {
+			c, err := compareOrder(keys, nodes[i].Data, nodes[j].Data)
+			if err != nil && cmpErr == nil {
+				cmpErr = err
+			}
+			return c < 0
+		})
+		if cmpErr != nil {
+			return cmpErr
+		}
+		var r int64
+		for i, n := range nodes {
+			if i == 0 {
+				r = 1
+			} else if c, _ := compareOrder(keys, nodes[i-1].Data, n.Data); c != 0 {
+				if dense {
+					r++
+				} else {
+					r = int64(i + 1)
+				}
+			}
+			ranks[n] = r
+		}
+	}
+
+	for n, r := range ranks {
+		n.Set(column, r)
+	}
+	ll.record("update", len(ranks), "%s %s", column, orderBy)
+	return nil
+}
@@ -0,0 +1,56 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func leaderboard() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "ann", "league": "x", "score": 90})
+	ll.Append(map[string]interface{}{"name": "bob", "league": "y", "score": 75})
+	ll.Append(map[string]interface{}{"name": "cid", "league": "x", "score": 95})
+	ll.Append(map[string]interface{}{"name": "dee", "league": "x", "score": 90})
+	ll.Append(map[string]interface{}{"name": "eve", "league": "x", "score": 80})
+	ll.Append(map[string]interface{}{"name": "fay", "league": "y", "score": nil})
+	return ll
+}
+
+func TestRank(t *testing.T) {
+	ll := leaderboard()
+	if err := ll.Rank("score DESC"); err != nil {
+		t.Fatalf("Rank failed: %v", err)
+	}
+	want := []interface{}{int64(2), int64(5), int64(1), int64(2), int64(4), int64(6)}
+	if got := columnValues(ll, "rank"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected ranks %v, got %v", want, got)
+	}
+	if ll.First().Data["name"] != "ann" {
+		t.Error("Expected list order unchanged")
+	}
+}
+
+func TestDenseRankPartitioned(t *testing.T) {
+	ll := leaderboard()
+	if err := ll.DenseRank("score DESC", "league"); err != nil {
+		t.Fatalf("DenseRank failed: %v", err)
+	}
+	want := []interface{}{int64(2), int64(1), int64(1), int64(2), int64(3), int64(2)}
+	if got := columnValues(ll, "dense_rank"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected dense ranks %v, got %v", want, got)
+	}
+}
+
+func TestRankErrors(t *testing.T) {
+	ll := leaderboard()
+	if err := ll.Rank("score SIDEWAYS"); err == nil {
+		t.Error("Expected error for invalid order spec, got nil")
+	}
+	ll.Append(map[string]interface{}{"name": "gus", "league": "x", "score": "high"})
+	if err := ll.Rank("score"); err == nil {
+		t.Error("Expected error for incomparable values, got nil")
+	}
+	if _, ok := ll.First().Data["rank"]; ok {
+		t.Error("Expected no rank written on error")
+	}
+}
@@ -0,0 +1,105 @@
+package linkedlist
+
+import "io"
+
+// ReadOnlyList exposes the traversal, scanning and export methods of a
+// LinkedList without any way to change it. Derived lists returned by Filter,
+// Where, Select and Aggregate are new, mutable lists.
+type ReadOnlyList interface {
+	First() *Node
+	Last() *Node
+	Len() int
+	IsEmpty() bool
+	Nodes() []*Node
+	Meta(key string) (interface{}, bool)
+	ScanFirst(dest interface{}) error
+	ToSlice(destSlice interface{}) error
+	Filter(keep func(*Node) bool) *LinkedList
+	Where(condition string) (*LinkedList, error)
+	Select(columns ...string) *LinkedList
+	Aggregate() *Aggregation
+	Render(w io.Writer, tmpl Template) error
+}
+
+// AsReadOnly returns a read-only view of the list's current contents. The
+// view is backed by Freeze, so later changes to ll are not visible through
+// it, and none of its methods move the shared iterator. First, Last and Nodes
+// return copies of the rows, so changing their Data does not reach the list.
+func (ll *LinkedList) AsReadOnly() ReadOnlyList {
+	return readOnlyList{ll: ll.Freeze()}
+}
+
+// readOnlyList implements ReadOnlyList over a frozen view.
+type readOnlyList struct {
+	ll *LinkedList
+}
+
+func (r readOnlyList) First() *Node {
+	return detachNode(r.ll.First())
+}
+
+func (r readOnlyList) Last() *Node {
+	return detachNode(r.ll.Last())
+}
+
+func (r readOnlyList) Len() int {
+	return r.ll.Len()
+}
+
+func (r readOnlyList) IsEmpty() bool {
+	return r.ll.IsEmpty()
+}
+
+func (r readOnlyList) Nodes() []*Node {
+	nodes := r.ll.Nodes()
+	for i, n := range nodes {
+		nodes[i] = detachNode(n)
+	}
+	return nodes
+}
+
+func (r readOnlyList) Meta(key string) (interface{}, bool) {
+	v, ok := r.ll.Meta(key)
+	return copyValue(v), ok
+}
+
+func (r readOnlyList) ScanFirst(dest interface{}) error {
+	return r.ll.ScanFirst(dest)
+}
+
+func (r readOnlyList) ToSlice(destSlice interface{}) error {
+	return r.ll.ToSlice(destSlice)
+}
+
+// Filter hands keep copies of the rows, like Nodes.
+func (r readOnlyList) Filter(keep func(*Node) bool) *LinkedList {
+	return r.ll.Filter(func(n *Node) bool { return keep(detachNode(n)) })
+}
+
+func (r readOnlyList) Where(condition string) (*LinkedList, error) {
+	return r.ll.Where(condition)
+}
+
+func (r readOnlyList) Select(columns ...string) *LinkedList {
+	return r.ll.Select(columns...)
+}
+
+func (r readOnlyList) Aggregate() *Aggregation {
+	return r.ll.Aggregate()
+}
+
+func (r readOnlyList) Render(w io.Writer, tmpl Template) error {
+	return r.ll.Render(w, tmpl)
+}
+
+// detachNode returns a copy of n whose Data shares no memory with it, or nil.
+func detachNode(n *Node) *Node {
+	if n == nil {
+		return nil
+	}
+	data := make(map[string]interface{}, len(n.Data))
+	for k, v := range n.Data {
+		data[k] = copyValue(v)
+	}
+	return n.derive(data)
+}
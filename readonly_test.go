@@ -0,0 +1,98 @@
+package linkedlist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestAsReadOnly(t *testing.T) {
+	type Person struct {
+		Name string `db:"name"`
+	}
+	ll := peopleList()
+	ro := ll.AsReadOnly()
+
+	ll.Append(map[string]interface{}{"name": "Eve", "city": "SF"})
+	if ro.Len() != 4 {
+		t.Errorf("Expected read-only view to keep 4 rows, got %d", ro.Len())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var people []Person
+			if err := ro.ToSlice(&people); err != nil || len(people) != 4 {
+				t.Errorf("Expected 4 people, got %d (err=%v)", len(people), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, err := ro.Where("city = 'LA'"); err != nil || got.Len() != 1 {
+		t.Errorf("Expected one LA row, got %v (err=%v)", got, err)
+	}
+}
+
+func TestAsReadOnly_NodesAreCopies(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "Ann", "tags": []interface{}{"a"}})
+	ro := ll.AsReadOnly()
+
+	ro.First().Data["name"] = "Bob"
+	ro.Nodes()[0].Data["tags"].([]interface{})[0] = "b"
+	ro.Filter(func(n *Node) bool {
+		n.Data["name"] = "Cy"
+		return true
+	})
+	if ro.First().Data["name"] != "Ann" || ll.First().Data["name"] != "Ann" {
+		t.Errorf("Expected the name to stay Ann, got %v", ll.First().Data["name"])
+	}
+	if tags := ll.First().Data["tags"].([]interface{}); tags[0] != "a" {
+		t.Errorf("Expected tags to stay unchanged, got %v", tags)
+	}
+	if _, ok := ro.(*LinkedList); ok {
+		t.Error("Expected the view not to be a *LinkedList")
+	}
+}
+
+func TestToSlice_KeepsIterator(t *testing.T) {
+	type Item struct{ ID int }
+	ll := New()
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.Append(map[string]interface{}{"ID": 2})
+
+	ll.ResetIterator()
+	ll.Next()
+	var items []Item
+	if err := ll.ToSlice(&items); err != nil || len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d (err=%v)", len(items), err)
+	}
+	if node := ll.Next(); node == nil || node.Data["ID"] != 2 {
+		t.Errorf("Expected iterator to stay at ID 2, got %+v", node)
+	}
+}
+
+func TestAsReadOnly_KeepsScanSettings(t *testing.T) {
+	type Invoice struct {
+		Price testCents `db:"price"`
+		Paid  bool      `db:"paid"`
+	}
+	ll := New(
+		WithConverter(reflect.TypeOf(testCents(0)), parseCents),
+		WithLenientBools(),
+	)
+	ll.Append(map[string]interface{}{"price": "$1.25", "paid": "t"})
+	ll.Append(map[string]interface{}{"price": "$0.50", "paid": "f"})
+
+	var invoices []Invoice
+	if err := ll.AsReadOnly().ToSlice(&invoices); err != nil {
+		t.Fatalf("ToSlice through the read-only view failed: %v", err)
+	}
+	want := []Invoice{{125, true}, {50, false}}
+	if !reflect.DeepEqual(invoices, want) {
+		t.Errorf("Expected %+v, got %+v", want, invoices)
+	}
+}
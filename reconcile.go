@@ -0,0 +1,128 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ReconcileReport is the result of comparing a source list with a target.
+type ReconcileReport struct {
+	// Matched holds the source rows found in target with equal values.
+	Matched *LinkedList
+	// MissingInTarget holds the source rows whose key is not in target.
+	MissingInTarget *LinkedList
+	// MissingInSource holds the target rows whose key is not in source.
+	MissingInSource *LinkedList
+	// Mismatches lists the rows present on both sides with differing values,
+	// in source order.
+	Mismatches []RowMismatch
+}
+
+// RowMismatch is a key present in both lists whose compared columns differ.
+type RowMismatch struct {
+	Key    interface{}
+	Source *Node
+	Target *Node
+	Diffs  []ColumnDiff
+}
+
+// ColumnDiff is one differing column of a RowMismatch.
+type ColumnDiff struct {
+	Column string
+	Source interface{}
+	Target interface{}
+}
+
+// Reconcile matches the rows of source and target on key and compares the
+// compareCols of each pair, numbers with the tolerance of Equal. With no
+// compareCols every column except key is compared. Keys must be unique on
+// each side. The lists in the report share Data with the inputs.
+func Reconcile(source, target *LinkedList, key string, compareCols []string) (*ReconcileReport, error) {
+	if key == "" {
+		return nil, errors.New("reconcile key must not be empty")
+	}
+	targets, err := indexUnique(target, key, "target")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := indexUnique(source, key, "source"); err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{Matched: New(), MissingInTarget: New(), MissingInSource: New()}
+	seen := make(map[string]bool, len(targets))
+	err = source.each(func(n *Node) error {
+		k := encodeKey([]interface{}{n.Data[key]})
+		t, ok := targets[k]
+		if !ok {
+			report.MissingInTarget.appendNode(n.shareCopy())
+			return nil
+		}
+		seen[k] = true
+		if diffs := diffColumns(n.Data, t.Data, key, compareCols); len(diffs) > 0 {
+			report.Mismatches = append(report.Mismatches, RowMismatch{Key: n.Data[key], Source: n, Target: t, Diffs: diffs})
+		} else {
+			report.Matched.appendNode(n.shareCopy())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = target.each(func(n *Node) error {
+		if !seen[encodeKey([]interface{}{n.Data[key]})] {
+			report.MissingInSource.appendNode(n.shareCopy())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// indexUnique maps the encoded key of every row of ll to its node.
+func indexUnique(ll *LinkedList, key, side string) (map[string]*Node, error) {
+	index := make(map[string]*Node)
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		k := encodeKey([]interface{}{n.Data[key]})
+		if _, dup := index[k]; dup {
+			return fmt.Errorf("duplicate %s key %v at row %d", side, n.Data[key], pos)
+		}
+		index[k] = n
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// diffColumns returns the columns that differ between x and y.
+func diffColumns(x, y map[string]interface{}, key string, columns []string) []ColumnDiff {
+	if len(columns) == 0 {
+		all := make(map[string]bool)
+		for c := range x {
+			all[c] = true
+		}
+		for c := range y {
+			all[c] = true
+		}
+		delete(all, key)
+		for c := range all {
+			columns = append(columns, c)
+		}
+		sort.Strings(columns)
+	}
+
+	var diffs []ColumnDiff
+	for _, c := range columns {
+		if !valuesEqual(x[c], y[c]) {
+			diffs = append(diffs, ColumnDiff{Column: c, Source: x[c], Target: y[c]})
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,66 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	source := New()
+	source.Append(map[string]interface{}{"id": 1, "name": "Alice", "balance": 10.0})
+	source.Append(map[string]interface{}{"id": 2, "name": "Bob", "balance": 20.0})
+	source.Append(map[string]interface{}{"id": 3, "name": "Carol", "balance": 30.0})
+	target := New()
+	target.Append(map[string]interface{}{"id": int64(2), "name": "Bob", "balance": 25})
+	target.Append(map[string]interface{}{"id": int64(3), "name": "Carol", "balance": 30})
+	target.Append(map[string]interface{}{"id": int64(4), "name": "Dan", "balance": 40})
+
+	report, err := Reconcile(source, target, "id", []string{"balance"})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if got := names(report.Matched); !reflect.DeepEqual(got, []string{"Carol"}) {
+		t.Errorf("Expected matched [Carol], got %v", got)
+	}
+	if got := names(report.MissingInTarget); !reflect.DeepEqual(got, []string{"Alice"}) {
+		t.Errorf("Expected missing in target [Alice], got %v", got)
+	}
+	if got := names(report.MissingInSource); !reflect.DeepEqual(got, []string{"Dan"}) {
+		t.Errorf("Expected missing in source [Dan], got %v", got)
+	}
+	if len(report.Mismatches) != 1 {
+		t.Fatalf("Expected 1 mismatch, got %+v", report.Mismatches)
+	}
+	m := report.Mismatches[0]
+	want := []ColumnDiff{{Column: "balance", Source: 20.0, Target: 25}}
+	if m.Key != 2 || !reflect.DeepEqual(m.Diffs, want) {
+		t.Errorf("Unexpected mismatch: %+v", m)
+	}
+}
+
+func TestReconcile_AllColumns(t *testing.T) {
+	source := New()
+	source.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	target := New()
+	target.Append(map[string]interface{}{"id": 1, "name": "Alicia", "email": "a@x.io"})
+
+	report, err := Reconcile(source, target, "id", nil)
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(report.Mismatches) != 1 || len(report.Mismatches[0].Diffs) != 2 || report.Mismatches[0].Diffs[0].Column != "email" {
+		t.Errorf("Expected diffs on email and name, got %+v", report.Mismatches)
+	}
+}
+
+func TestReconcile_DuplicateKey(t *testing.T) {
+	target := New()
+	target.Append(map[string]interface{}{"id": 1})
+	target.Append(map[string]interface{}{"id": 1})
+	if _, err := Reconcile(New(), target, "id", nil); err == nil {
+		t.Error("Expected error for duplicate target key")
+	}
+	if _, err := Reconcile(New(), New(), "", nil); err == nil {
+		t.Error("Expected error for empty key")
+	}
+}
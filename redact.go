@@ -0,0 +1,96 @@
+package linkedlist
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// RedactStrategy selects how Redact masks a column.
+type RedactStrategy int
+
+const (
+	// RedactMask replaces every character of the value with '*'.
+	RedactMask RedactStrategy = iota
+	// RedactHash replaces the value with the hex HMAC-SHA256 of its text
+	// under a secret key, so equal values stay joinable without being
+	// readable or guessable from a list of candidates. It needs a key, see
+	// RedactWithKey.
+	RedactHash
+	// RedactDrop removes the column from the row.
+	RedactDrop
+)
+
+// Redact masks the given columns in every row with strategy. Column names
+// match case-insensitively and NULL values are left as they are, except with
+// RedactDrop which removes the column regardless of its value. RedactHash
+// needs a key and fails here; use RedactWithKey. Lists with spilled rows
+// return ErrSpilled and are left unredacted.
+func (ll *LinkedList) Redact(columns []string, strategy RedactStrategy) error {
+	return ll.RedactWithKey(columns, strategy, nil)
+}
+
+// RedactWithKey is Redact with the secret key RedactHash digests under. Lists
+// hashed with the same key can still be joined on the redacted columns; keep
+// the key out of the exported data.
+func (ll *LinkedList) RedactWithKey(columns []string, strategy RedactStrategy, key []byte) error {
+	if strategy < RedactMask || strategy > RedactDrop {
+		return fmt.Errorf("unknown redact strategy %d", strategy)
+	}
+	if strategy == RedactHash && len(key) == 0 {
+		return errors.New("RedactHash requires a non-empty key")
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	for n := ll.front(); n != nil; n = n.succ() {
+		for k, v := range n.Data {
+			if !matchesAny(k, columns) {
+				continue
+			}
+			n.own()
+			switch {
+			case strategy == RedactDrop:
+				delete(n.Data, k)
+			case v == nil:
+			case strategy == RedactHash:
+				mac := hmac.New(sha256.New, key)
+				mac.Write([]byte(valueText(v)))
+				n.Data[k] = hex.EncodeToString(mac.Sum(nil))
+			default:
+				n.Data[k] = strings.Repeat("*", utf8.RuneCountInString(valueText(v)))
+			}
+		}
+	}
+	ll.record("update", ll.len, "Redact %s", auditColumns(columns))
+	return nil
+}
+
+// matchesAny reports whether key equals one of names, ignoring case.
+func matchesAny(key string, names []string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueText renders a value as text, treating []byte as a string.
+func valueText(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,90 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func customers() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "email": "a@x.io", "ssn": "123-45", "phone": nil})
+	ll.Append(map[string]interface{}{"id": 2, "email": "a@x.io", "ssn": []byte("999"), "phone": "555"})
+	return ll
+}
+
+func TestRedact_Strategies(t *testing.T) {
+	ll := customers()
+	if err := ll.Redact([]string{"SSN", "phone"}, RedactMask); err != nil {
+		t.Fatalf("Redact failed: %v", err)
+	}
+	if ll.First().Data["ssn"] != "******" || ll.Last().Data["ssn"] != "***" {
+		t.Errorf("Unexpected masks: %v, %v", ll.First().Data["ssn"], ll.Last().Data["ssn"])
+	}
+	if ll.First().Data["phone"] != nil {
+		t.Errorf("Expected NULL to stay NULL, got %v", ll.First().Data["phone"])
+	}
+
+	if err := ll.Redact([]string{"email"}, RedactHash); err == nil {
+		t.Error("Expected error hashing without a key")
+	}
+	if err := ll.RedactWithKey([]string{"email"}, RedactHash, []byte("secret")); err != nil {
+		t.Fatalf("RedactWithKey failed: %v", err)
+	}
+	h1, h2 := ll.First().Data["email"].(string), ll.Last().Data["email"].(string)
+	if len(h1) != 64 || h1 != h2 || h1 == "a@x.io" {
+		t.Errorf("Expected equal HMAC digests, got %q and %q", h1, h2)
+	}
+	// sha256("a@x.io"), which anyone holding a list of emails could match.
+	if h1 == "aa8a6b1226018606fb52fd4fb5e6f407d7a610c996250306acf156a247c08e26" {
+		t.Error("Expected a keyed digest")
+	}
+	other := customers()
+	if err := other.RedactWithKey([]string{"email"}, RedactHash, []byte("other")); err != nil {
+		t.Fatalf("RedactWithKey failed: %v", err)
+	}
+	if other.First().Data["email"] == h1 {
+		t.Error("Expected different keys to give different digests")
+	}
+
+	if err := ll.Redact([]string{"email", "phone"}, RedactDrop); err != nil {
+		t.Fatalf("Redact failed: %v", err)
+	}
+	if _, ok := ll.First().Data["email"]; ok {
+		t.Error("Expected email to be dropped")
+	}
+	if _, ok := ll.First().Data["phone"]; ok {
+		t.Error("Expected NULL phone to be dropped")
+	}
+}
+
+func TestRedact_CopyOnWriteAndErrors(t *testing.T) {
+	ll := customers()
+	derived := ll.Filter(func(*Node) bool { return true })
+	if err := derived.Redact([]string{"email"}, RedactMask); err != nil {
+		t.Fatalf("Redact failed: %v", err)
+	}
+	if ll.First().Data["email"] != "a@x.io" {
+		t.Error("Redacting a derived list changed the source")
+	}
+
+	if err := ll.Redact([]string{"email"}, RedactStrategy(42)); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+	if err := ll.Freeze().Redact([]string{"email"}, RedactMask); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestRedact_Spilled(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+	for i := 0; i < 5; i++ {
+		ll.Append(map[string]interface{}{"ssn": "123-45"})
+	}
+	if err := ll.Redact([]string{"ssn"}, RedactMask); !errors.Is(err, ErrSpilled) {
+		t.Fatalf("Expected ErrSpilled, got %v", err)
+	}
+	if ll.Last().Data["ssn"] != "123-45" {
+		t.Error("Expected resident rows to be left alone when the list is refused")
+	}
+}
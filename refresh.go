@@ -0,0 +1,94 @@
+package linkedlist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Refreshing holds a list that is reloaded in the background on an interval.
+// It is safe for concurrent use.
+type Refreshing struct {
+	loader func(ctx context.Context) (*LinkedList, error)
+	data   atomic.Pointer[LinkedList]
+
+	// reloading serialises Refresh, so a slow load never replaces the
+	// result of a later one.
+	reloading sync.Mutex
+
+	mu      sync.Mutex
+	lastErr error
+	loaded  time.Time
+}
+
+// NewRefreshing loads the list with loader and reloads it every interval
+// until ctx is done. The first load happens before NewRefreshing returns and
+// its error is returned. A failed reload keeps the previous data; see Err.
+func NewRefreshing(ctx context.Context, loader func(ctx context.Context) (*LinkedList, error), interval time.Duration) (*Refreshing, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("refresh interval %v must be positive", interval)
+	}
+	r := &Refreshing{loader: loader}
+	if err := r.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.Refresh(ctx)
+			}
+		}
+	}()
+	return r, nil
+}
+
+// Refresh reloads the list now, swapping in the new data atomically when
+// loader succeeds. Overlapping calls run one after another, so the data
+// published last comes from the call that started last.
+func (r *Refreshing) Refresh(ctx context.Context) error {
+	r.reloading.Lock()
+	defer r.reloading.Unlock()
+
+	ll, err := r.loader(ctx)
+	if err == nil && ll == nil {
+		err = ErrNilList
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastErr = err
+	if err != nil {
+		return err
+	}
+	r.data.Store(ll.Freeze())
+	r.loaded = time.Now()
+	return nil
+}
+
+// Snapshot returns a frozen view of the current data. A snapshot never
+// changes, even when newer data is swapped in.
+func (r *Refreshing) Snapshot() *LinkedList {
+	return r.data.Load().Freeze()
+}
+
+// Err returns the error of the most recent load, or nil if it succeeded.
+func (r *Refreshing) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastErr
+}
+
+// LoadedAt returns when the current data was loaded.
+func (r *Refreshing) LoadedAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loaded
+}
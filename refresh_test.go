@@ -0,0 +1,112 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRefreshing_SwapsData(t *testing.T) {
+	var version atomic.Int64
+	loader := func(context.Context) (*LinkedList, error) {
+		ll := New()
+		ll.Append(map[string]interface{}{"version": version.Add(1)})
+		return ll, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := NewRefreshing(ctx, loader, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewRefreshing failed: %v", err)
+	}
+	first := r.Snapshot()
+	if first.First().Data["version"] != int64(1) || !first.IsFrozen() {
+		t.Fatalf("Expected frozen version 1, got %v", first.First().Data)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for r.Snapshot().First().Data["version"] == int64(1) {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected data to be refreshed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if first.First().Data["version"] != int64(1) {
+		t.Error("Expected an old snapshot to stay unchanged")
+	}
+}
+
+func TestNewRefreshing_Errors(t *testing.T) {
+	boom := errors.New("boom")
+	if _, err := NewRefreshing(context.Background(), func(context.Context) (*LinkedList, error) {
+		return nil, boom
+	}, time.Second); !errors.Is(err, boom) {
+		t.Errorf("Expected first load error, got %v", err)
+	}
+
+	fail := false
+	loader := func(context.Context) (*LinkedList, error) {
+		if fail {
+			return nil, boom
+		}
+		ll := New()
+		ll.Append(map[string]interface{}{"id": 1})
+		return ll, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := NewRefreshing(ctx, loader, time.Hour)
+	if err != nil {
+		t.Fatalf("NewRefreshing failed: %v", err)
+	}
+	fail = true
+	if err := r.Refresh(ctx); !errors.Is(err, boom) || !errors.Is(r.Err(), boom) {
+		t.Errorf("Expected refresh error to be reported, got %v", err)
+	}
+	if r.Snapshot().Len() != 1 {
+		t.Error("Expected previous data to be kept after a failed refresh")
+	}
+}
+
+func TestRefreshing_OverlappingRefreshKeepsOrder(t *testing.T) {
+	var calls atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	loader := func(context.Context) (*LinkedList, error) {
+		call := calls.Add(1)
+		if call == 2 {
+			// The first Refresh after NewRefreshing's own load is slow.
+			close(started)
+			<-release
+		}
+		ll := New()
+		ll.Append(map[string]interface{}{"call": call})
+		return ll, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := NewRefreshing(ctx, loader, time.Hour)
+	if err != nil {
+		t.Fatalf("NewRefreshing failed: %v", err)
+	}
+
+	slow := make(chan error)
+	go func() { slow <- r.Refresh(ctx) }()
+	<-started
+	fast := make(chan error)
+	go func() { fast <- r.Refresh(ctx) }()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if err := <-slow; err != nil {
+		t.Fatalf("Slow refresh failed: %v", err)
+	}
+	if err := <-fast; err != nil {
+		t.Fatalf("Fast refresh failed: %v", err)
+	}
+
+	if got := r.Snapshot().First().Data["call"]; got != int64(3) {
+		t.Errorf("Expected data from the later refresh, got call %v", got)
+	}
+}
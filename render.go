@@ -0,0 +1,47 @@
+package linkedlist
+
+import (
+	"errors"
+	"io"
+)
+
+// Template is implemented by both text/template and html/template templates.
+type Template interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// Render executes tmpl with the list as its data. Templates can range over
+// the list's nodes and read columns with Get, for example:
+//
+//	{{range .Nodes}}<li>{{.Get "name"}}</li>{{end}}
+func (ll *LinkedList) Render(w io.Writer, tmpl Template) error {
+	if tmpl == nil {
+		return errors.New("template must not be nil")
+	}
+	if err := tmpl.Execute(w, ll); err != nil {
+		return err
+	}
+	ll.record("export", ll.Len(), "Render")
+	return nil
+}
+
+// Nodes returns the nodes of the list in order, spilled ones included as
+// read-only copies. Read errors are reported by SpillErr.
+func (ll *LinkedList) Nodes() []*Node {
+	if ll == nil {
+		return nil
+	}
+	nodes := make([]*Node, 0, ll.Len())
+	ll.each(func(n *Node) error {
+		nodes = append(nodes, n)
+		return nil
+	})
+	return nodes
+}
+
+// Get returns the value of column, matching the name case-insensitively if
+// there is no exact match. It returns nil for missing columns.
+func (n *Node) Get(column string) interface{} {
+	v, _ := lookupColumn(n.Data, column)
+	return v
+}
@@ -0,0 +1,43 @@
+package linkedlist
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+)
+
+func TestRender_HTMLTemplate(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "Alice"})
+	ll.Append(map[string]interface{}{"name": "<Bob>"})
+
+	tmpl := htmltemplate.Must(htmltemplate.New("t").Parse(
+		`{{.Len}}:{{range .Nodes}}<li>{{.Get "NAME"}}</li>{{end}}`))
+
+	var buf bytes.Buffer
+	if err := ll.Render(&buf, tmpl); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	want := "2:<li>Alice</li><li>&lt;Bob&gt;</li>"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestRender_TextTemplate(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1})
+
+	tmpl := template.Must(template.New("t").Parse(`{{range .Nodes}}{{.Data.id}}{{end}}`))
+	var buf bytes.Buffer
+	if err := ll.Render(&buf, tmpl); err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if buf.String() != "1" {
+		t.Errorf("Expected '1', got %q", buf.String())
+	}
+	if err := ll.Render(&buf, nil); err == nil {
+		t.Error("Expected error for nil template")
+	}
+}
@@ -0,0 +1,109 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// maxResampleBuckets caps the number of rows Resample returns.
+const maxResampleBuckets = 1 << 20
+
+// bucketKey identifies a Resample bucket by its start instant. Unlike
+// UnixNano it is defined for every time.
+type bucketKey struct {
+	sec  int64
+	nsec int
+}
+
+func keyOfBucket(t time.Time) bucketKey {
+	return bucketKey{t.Unix(), t.Nanosecond()}
+}
+
+// Resample groups rows into consecutive buckets of interval by timeColumn
+// and returns one row per bucket, oldest first, holding the bucket start
+// under timeColumn and, for each entry of aggs, the aggregate of that
+// column under the same name. Buckets are aligned to multiples of interval
+// since the zero time, as time.Truncate does, and empty buckets between the
+// first and last are included with NULL aggregates and zero counts, up to
+// maxResampleBuckets buckets in all; a wider span is an error. Rows with a
+// NULL time are skipped; times may also be RFC 3339 strings.
+func (ll *LinkedList) Resample(timeColumn string, interval time.Duration, aggs map[string]AggFunc) (*LinkedList, error) {
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	columns := make([]string, 0, len(aggs))
+	for c, fn := range aggs {
+		if fn < AggCount || fn > AggConcat {
+			return nil, fmt.Errorf("unknown aggregate %d for column %s", fn, c)
+		}
+		columns = append(columns, c)
+	}
+	sort.Strings(columns)
+
+	buckets := make(map[bucketKey][]aggState)
+	var first, last time.Time
+	pos := 0
+	err := ll.each(func(n *Node) error {
+		pos++
+		raw, _ := lookupColumn(n.Data, timeColumn)
+		if raw == nil {
+			return nil
+		}
+		t, ok := (*scanner)(nil).scanTime(raw)
+		if !ok {
+			return fmt.Errorf("row %d: %s value %v is not a time", pos, timeColumn, raw)
+		}
+		start := t.Truncate(interval)
+		states, ok := buckets[keyOfBucket(start)]
+		if !ok {
+			states = make([]aggState, len(columns))
+			buckets[keyOfBucket(start)] = states
+			if len(buckets) == 1 || start.Before(first) {
+				first = start
+			}
+			if len(buckets) == 1 || start.After(last) {
+				last = start
+			}
+		}
+		for i, c := range columns {
+			v, _ := lookupColumn(n.Data, c)
+			if err := states[i].add(aggs[c], v); err != nil {
+				return fmt.Errorf("row %d: error aggregating %s: %w", pos, c, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := New()
+	if len(buckets) == 0 {
+		return result, nil
+	}
+	// Sub saturates for spans beyond time.Duration, which also fails here.
+	if span := last.Sub(first) / interval; span >= maxResampleBuckets {
+		return nil, fmt.Errorf("resampling %v to %v by %v needs more than %d buckets", first, last, interval, maxResampleBuckets)
+	}
+	for start := first; !start.After(last); start = start.Add(interval) {
+		row := make(map[string]interface{}, len(columns)+1)
+		row[timeColumn] = start
+		states := buckets[keyOfBucket(start)]
+		for i, c := range columns {
+			switch {
+			case aggs[c] == AggCount && states == nil:
+				row[c] = int64(0)
+			case aggs[c] == AggCount:
+				row[c] = states[i].count
+			case states == nil:
+				row[c] = nil
+			default:
+				row[c] = states[i].result(aggs[c])
+			}
+		}
+		result.Append(row)
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResample(t *testing.T) {
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	ll := New()
+	ll.Append(map[string]interface{}{"at": base.Add(1 * time.Minute), "price": 10, "qty": 1})
+	ll.Append(map[string]interface{}{"at": base.Add(4 * time.Minute), "price": 20, "qty": 2})
+	ll.Append(map[string]interface{}{"at": base.Add(12 * time.Minute).Format(time.RFC3339), "price": 30.5, "qty": nil})
+	ll.Append(map[string]interface{}{"at": nil, "price": 99})
+
+	out, err := ll.Resample("at", 5*time.Minute, map[string]AggFunc{"price": AggAvg, "qty": AggCount})
+	if err != nil {
+		t.Fatalf("Resample failed: %v", err)
+	}
+	if out.Len() != 3 {
+		t.Fatalf("Expected 3 buckets, got %d", out.Len())
+	}
+	want := []map[string]interface{}{
+		{"at": base, "price": 15.0, "qty": int64(2)},
+		{"at": base.Add(5 * time.Minute), "price": nil, "qty": int64(0)},
+		{"at": base.Add(10 * time.Minute), "price": 30.5, "qty": int64(0)},
+	}
+	i := 0
+	for n := out.First(); n != nil; n = n.next {
+		if !n.Data["at"].(time.Time).Equal(want[i]["at"].(time.Time)) {
+			t.Errorf("Expected bucket %d at %v, got %v", i, want[i]["at"], n.Data["at"])
+		}
+		for _, c := range []string{"price", "qty"} {
+			if n.Data[c] != want[i][c] {
+				t.Errorf("Expected bucket %d %s %v, got %v", i, c, want[i][c], n.Data[c])
+			}
+		}
+		i++
+	}
+}
+
+func TestResampleErrors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"at": "yesterday"})
+	if _, err := ll.Resample("at", time.Hour, nil); err == nil {
+		t.Error("Expected error for non-time value, got nil")
+	}
+	if _, err := ll.Resample("at", 0, nil); err == nil {
+		t.Error("Expected error for zero interval, got nil")
+	}
+	if _, err := New().Resample("at", time.Hour, map[string]AggFunc{"x": AggFunc(42)}); err == nil {
+		t.Error("Expected error for unknown aggregate, got nil")
+	}
+}
+
+func TestResampleWideSpan(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"at": time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)})
+	ll.Append(map[string]interface{}{"at": time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if _, err := ll.Resample("at", time.Second, map[string]AggFunc{"at": AggCount}); err == nil {
+		t.Error("Expected error for a span needing too many buckets")
+	}
+
+	old := New()
+	old.Append(map[string]interface{}{"at": time.Date(1500, 1, 1, 0, 0, 30, 0, time.UTC), "v": 1})
+	old.Append(map[string]interface{}{"at": time.Date(1500, 1, 1, 0, 1, 30, 0, time.UTC), "v": 2})
+	out, err := old.Resample("at", time.Minute, map[string]AggFunc{"v": AggSum})
+	if err != nil {
+		t.Fatalf("Resample failed: %v", err)
+	}
+	if out.Len() != 2 || out.Last().Data["v"] != int64(2) {
+		t.Errorf("Expected 2 buckets before 1678, got %d: %v", out.Len(), out.Last().Data)
+	}
+}
@@ -0,0 +1,151 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// WeightedSample returns n rows drawn without replacement, each with
+// probability proportional to its weightColumn value. Rows with weight 0 are
+// never drawn; fewer than n rows are returned if fewer have a positive
+// weight. Rows keep their list order. Output is deterministic for a given
+// list; use WeightedSampleSeed for other draws.
+func (ll *LinkedList) WeightedSample(weightColumn string, n int) (*LinkedList, error) {
+	return ll.WeightedSampleSeed(weightColumn, n, 1)
+}
+
+// WeightedSampleSeed is WeightedSample with an explicit random seed.
+func (ll *LinkedList) WeightedSampleSeed(weightColumn string, n int, seed int64) (*LinkedList, error) {
+	type keyed struct {
+		pos int
+		key float64
+		n   *Node
+	}
+	rng := rand.New(rand.NewSource(seed))
+	var rows []keyed
+	pos := 0
+	err := ll.each(func(node *Node) error {
+		pos++
+		w, ok := toFloat64(node.Data[weightColumn])
+		if !ok || w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return fmt.Errorf("row %d: invalid weight %v", pos, node.Data[weightColumn])
+		}
+		if w == 0 {
+			return nil
+		}
+		// Efraimidis-Spirakis: the n largest u^(1/w) form a weighted sample.
+		rows = append(rows, keyed{pos: pos, key: math.Pow(rng.Float64(), 1/w), n: node})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].key > rows[j].key })
+	if n < len(rows) {
+		rows = rows[:max(n, 0)]
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].pos < rows[j].pos })
+
+	result := New()
+	for _, r := range rows {
+		result.appendNode(r.n.shareCopy())
+	}
+	ll.recordDerived(result, "WeightedSample %s n=%d", weightColumn, n)
+	return result, nil
+}
+
+// StratifiedSplit splits the rows into one list per fraction, such as 0.8
+// and 0.2 for train and validation sets, so that every value of column is
+// spread over the lists in those proportions. Fractions must be positive
+// and sum to 1. Rows are assigned at random and keep their list order.
+// Output is deterministic for a given list; use StratifiedSplitSeed for
+// other splits. Like Filter, the lists share Data with ll.
+func (ll *LinkedList) StratifiedSplit(column string, fractions ...float64) ([]*LinkedList, error) {
+	return ll.StratifiedSplitSeed(1, column, fractions...)
+}
+
+// StratifiedSplitSeed is StratifiedSplit with an explicit random seed.
+func (ll *LinkedList) StratifiedSplitSeed(seed int64, column string, fractions ...float64) ([]*LinkedList, error) {
+	if len(fractions) == 0 {
+		return nil, errors.New("no fractions given")
+	}
+	sum := 0.0
+	for _, f := range fractions {
+		if !(f > 0) {
+			return nil, fmt.Errorf("fraction %v must be positive", f)
+		}
+		sum += f
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		return nil, fmt.Errorf("fractions sum to %v, not 1", sum)
+	}
+
+	var nodes []*Node
+	groups := make(map[string][]int)
+	var order []string
+	err := ll.each(func(n *Node) error {
+		k := encodeKey([]interface{}{n.Data[column]})
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], len(nodes))
+		nodes = append(nodes, n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	assign := make([]int, len(nodes))
+	for _, k := range order {
+		members := groups[k]
+		rng.Shuffle(len(members), func(i, j int) { members[i], members[j] = members[j], members[i] })
+		start := 0
+		for part, size := range splitCounts(len(members), fractions) {
+			for _, idx := range members[start : start+size] {
+				assign[idx] = part
+			}
+			start += size
+		}
+	}
+
+	parts := make([]*LinkedList, len(fractions))
+	for i := range parts {
+		parts[i] = New()
+	}
+	for i, n := range nodes {
+		parts[assign[i]].appendNode(n.shareCopy())
+	}
+	for i, p := range parts {
+		ll.recordDerived(p, "StratifiedSplit %s part=%d fraction=%v", column, i, fractions[i])
+	}
+	return parts, nil
+}
+
+// splitCounts divides total items by fractions with the largest remainder
+// method, so the counts sum to total and earlier parts win ties.
+func splitCounts(total int, fractions []float64) []int {
+	counts := make([]int, len(fractions))
+	rems := make([]float64, len(fractions))
+	assigned := 0
+	for i, f := range fractions {
+		exact := f * float64(total)
+		counts[i] = int(math.Floor(exact))
+		rems[i] = exact - float64(counts[i])
+		assigned += counts[i]
+	}
+	idx := make([]int, len(fractions))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return rems[idx[a]] > rems[idx[b]] })
+	for i := 0; assigned < total; i++ {
+		counts[idx[i%len(idx)]]++
+		assigned++
+	}
+	return counts
+}
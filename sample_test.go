@@ -0,0 +1,114 @@
+package linkedlist
+
+import (
+	"fmt"
+	"testing"
+)
+
+func labeledList(counts map[string]int) *LinkedList {
+	ll := New()
+	id := 0
+	for _, label := range []string{"cat", "dog", "bird"} {
+		for i := 0; i < counts[label]; i++ {
+			id++
+			ll.Append(map[string]interface{}{"id": id, "label": label})
+		}
+	}
+	return ll
+}
+
+func TestStratifiedSplit(t *testing.T) {
+	ll := labeledList(map[string]int{"cat": 50, "dog": 30, "bird": 10})
+
+	parts, err := ll.StratifiedSplit("label", 0.8, 0.2)
+	if err != nil {
+		t.Fatalf("StratifiedSplit failed: %v", err)
+	}
+	if len(parts) != 2 {
+		t.Fatalf("Expected 2 parts, got %d", len(parts))
+	}
+	want := []map[string]int{
+		{"cat": 40, "dog": 24, "bird": 8},
+		{"cat": 10, "dog": 6, "bird": 2},
+	}
+	for i, p := range parts {
+		got := map[string]int{}
+		prev := 0
+		for n := p.First(); n != nil; n = n.next {
+			got[n.Data["label"].(string)]++
+			if id := n.Data["id"].(int); id < prev {
+				t.Errorf("Expected part %d in list order, got %d after %d", i, id, prev)
+			} else {
+				prev = id
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want[i]) {
+			t.Errorf("Expected part %d counts %v, got %v", i, want[i], got)
+		}
+	}
+
+	again, _ := ll.StratifiedSplit("label", 0.8, 0.2)
+	if !Equal(parts[1], again[1]) {
+		t.Error("Expected StratifiedSplit to be deterministic")
+	}
+}
+
+func TestStratifiedSplitSmallGroups(t *testing.T) {
+	ll := labeledList(map[string]int{"cat": 1, "dog": 2, "bird": 3})
+	parts, err := ll.StratifiedSplitSeed(7, "label", 0.5, 0.25, 0.25)
+	if err != nil {
+		t.Fatalf("StratifiedSplitSeed failed: %v", err)
+	}
+	total := 0
+	for _, p := range parts {
+		total += p.Len()
+	}
+	if total != ll.Len() {
+		t.Errorf("Expected %d rows across parts, got %d", ll.Len(), total)
+	}
+}
+
+func TestStratifiedSplitInvalidFractions(t *testing.T) {
+	ll := labeledList(map[string]int{"cat": 2})
+	for _, fr := range [][]float64{nil, {0.5, 0.4}, {1.2, -0.2}} {
+		if _, err := ll.StratifiedSplit("label", fr...); err == nil {
+			t.Errorf("Expected error for fractions %v, got nil", fr)
+		}
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "w": 0})
+	ll.Append(map[string]interface{}{"id": 2, "w": 1000})
+	ll.Append(map[string]interface{}{"id": 3, "w": 1})
+	ll.Append(map[string]interface{}{"id": 4, "w": 0.5})
+
+	hits := map[int]int{}
+	for seed := int64(0); seed < 200; seed++ {
+		s, err := ll.WeightedSampleSeed("w", 1, seed)
+		if err != nil {
+			t.Fatalf("WeightedSampleSeed failed: %v", err)
+		}
+		hits[s.First().Data["id"].(int)]++
+	}
+	if hits[1] != 0 {
+		t.Errorf("Expected zero-weight row never drawn, got %d", hits[1])
+	}
+	if hits[2] < 190 {
+		t.Errorf("Expected heavy row drawn most often, got %d of 200", hits[2])
+	}
+
+	all, err := ll.WeightedSample("w", 10)
+	if err != nil {
+		t.Fatalf("WeightedSample failed: %v", err)
+	}
+	if all.Len() != 3 || all.First().Data["id"] != 2 {
+		t.Errorf("Expected the 3 weighted rows in order, got %d rows", all.Len())
+	}
+
+	ll.Append(map[string]interface{}{"id": 5, "w": -1})
+	if _, err := ll.WeightedSample("w", 1); err == nil {
+		t.Error("Expected error for negative weight, got nil")
+	}
+}
@@ -0,0 +1,57 @@
+package linkedlist
+
+import "sort"
+
+// Intersect returns a new list with the rows of a whose key also occurs in b.
+// The key is made of keyColumns, compared like GroupBy keys, or of every
+// column when no key columns are given. The result shares Data with a until
+// either side changes it.
+func Intersect(a, b *LinkedList, keyColumns ...string) *LinkedList {
+	keys := keySet(b, keyColumns)
+	return a.Filter(func(n *Node) bool {
+		return keys[rowKey(n.Data, keyColumns)]
+	})
+}
+
+// Except returns a new list with the rows of a whose key does not occur in
+// b. Keys are formed as in Intersect.
+func Except(a, b *LinkedList, keyColumns ...string) *LinkedList {
+	keys := keySet(b, keyColumns)
+	return a.Filter(func(n *Node) bool {
+		return !keys[rowKey(n.Data, keyColumns)]
+	})
+}
+
+// keySet collects the row keys of ll, spilled rows included. Read errors
+// are reported by ll's SpillErr.
+func keySet(ll *LinkedList, keyColumns []string) map[string]bool {
+	keys := make(map[string]bool)
+	ll.each(func(n *Node) error {
+		keys[rowKey(n.Data, keyColumns)] = true
+		return nil
+	})
+	return keys
+}
+
+// rowKey encodes the keyColumns of a row, or the whole row when keyColumns
+// is empty. Missing columns encode as NULL.
+func rowKey(data map[string]interface{}, keyColumns []string) string {
+	if len(keyColumns) > 0 {
+		values := make([]interface{}, len(keyColumns))
+		for i, c := range keyColumns {
+			values[i] = data[c]
+		}
+		return encodeKey(values)
+	}
+
+	columns := make([]string, 0, len(data))
+	for c := range data {
+		columns = append(columns, c)
+	}
+	sort.Strings(columns)
+	values := make([]interface{}, 0, 2*len(columns))
+	for _, c := range columns {
+		values = append(values, c, data[c])
+	}
+	return encodeKey(values)
+}
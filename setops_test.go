@@ -0,0 +1,46 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func setopsLists() (*LinkedList, *LinkedList) {
+	source := New()
+	source.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	source.Append(map[string]interface{}{"id": 2, "name": "Bob"})
+	source.Append(map[string]interface{}{"id": 3, "name": "Carol"})
+	target := New()
+	target.Append(map[string]interface{}{"id": int64(2), "name": "Bob"})
+	target.Append(map[string]interface{}{"id": 3.0, "name": "Caroline"})
+	return source, target
+}
+
+func TestIntersect_ByKey(t *testing.T) {
+	source, target := setopsLists()
+	got := names(Intersect(source, target, "id"))
+	if !reflect.DeepEqual(got, []string{"Bob", "Carol"}) {
+		t.Errorf("Expected [Bob Carol], got %v", got)
+	}
+}
+
+func TestExcept_ByKey(t *testing.T) {
+	source, target := setopsLists()
+	got := names(Except(source, target, "id"))
+	if !reflect.DeepEqual(got, []string{"Alice"}) {
+		t.Errorf("Expected [Alice], got %v", got)
+	}
+}
+
+func TestIntersectExcept_WholeRow(t *testing.T) {
+	source, target := setopsLists()
+	if got := names(Intersect(source, target)); !reflect.DeepEqual(got, []string{"Bob"}) {
+		t.Errorf("Expected [Bob], got %v", got)
+	}
+	if got := names(Except(source, target)); !reflect.DeepEqual(got, []string{"Alice", "Carol"}) {
+		t.Errorf("Expected [Alice Carol], got %v", got)
+	}
+	if Except(nil, target).Len() != 0 || Except(source, nil).Len() != 3 {
+		t.Error("Expected nil lists to act as empty")
+	}
+}
@@ -0,0 +1,39 @@
+package linkedlist
+
+import "errors"
+
+// Shift writes into each row a newName column holding the column value of
+// the row offset positions earlier in the list, like SQL LAG for positive
+// offsets and LEAD for negative ones. Rows without such a neighbour get
+// nil. Order the list first, e.g. with OrderBy, to shift along a sort key.
+func (ll *LinkedList) Shift(column string, offset int, newName string) error {
+	if newName == "" {
+		return errors.New("new column name must not be empty")
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+
+	var nodes []*Node
+	var values []interface{}
+	for n := ll.front(); n != nil; n = n.succ() {
+		v, _ := lookupColumn(n.Data, column)
+		nodes = append(nodes, n)
+		values = append(values, v)
+	}
+	for i, n := range nodes {
+		var v interface{}
+		if j := i - offset; j >= 0 && j < len(values) {
+			v = values[j]
+		}
+		n.Set(newName, v)
+	}
+	ll.record("update", len(nodes), "Shift %s by %d as %s", column, offset, newName)
+	return nil
+}
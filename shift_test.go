@@ -0,0 +1,45 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShift(t *testing.T) {
+	cases := []struct {
+		offset int
+		want   []interface{}
+	}{
+		{1, []interface{}{nil, 1, 2, 3}},
+		{-1, []interface{}{2, 3, 4, nil}},
+		{0, []interface{}{1, 2, 3, 4}},
+		{5, []interface{}{nil, nil, nil, nil}},
+	}
+	for _, c := range cases {
+		ll := New()
+		for i := 1; i <= 4; i++ {
+			ll.Append(map[string]interface{}{"v": i})
+		}
+		if err := ll.Shift("v", c.offset, "shifted"); err != nil {
+			t.Fatalf("Shift(%d) failed: %v", c.offset, err)
+		}
+		if got := columnValues(ll, "shifted"); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Expected %v for offset %d, got %v", c.want, c.offset, got)
+		}
+	}
+}
+
+func TestShiftSelf(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"v": 1})
+	ll.Append(map[string]interface{}{"v": 2})
+	if err := ll.Shift("v", 1, "v"); err != nil {
+		t.Fatalf("Shift failed: %v", err)
+	}
+	if got := columnValues(ll, "v"); !reflect.DeepEqual(got, []interface{}{nil, 1}) {
+		t.Errorf("Expected [nil 1], got %v", got)
+	}
+	if err := ll.Shift("v", 1, ""); err == nil {
+		t.Error("Expected error for empty column name, got nil")
+	}
+}
@@ -0,0 +1,84 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+	"time"
+)
+
+func snapshotSource() *LinkedList {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": int64(1), "email": "a@x.io", "at": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	ll.Append(map[string]interface{}{"id": int64(2), "email": nil})
+	ll.Last().source = "users"
+	ll.Last().rowNum = 2
+	return ll
+}
+
+func TestSaveLoad_Plain(t *testing.T) {
+	src := snapshotSource()
+	var buf bytes.Buffer
+	if err := src.Save(&buf, SnapshotOptions{}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	dst := New()
+	if err := dst.Load(&buf, SnapshotOptions{}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !Equal(src, dst) {
+		t.Errorf("Loaded rows differ: %+v", dst.First().Data)
+	}
+	if dst.Last().Source() != "users" || dst.Last().RowNum() != 2 {
+		t.Errorf("Expected provenance to survive, got %q/%d", dst.Last().Source(), dst.Last().RowNum())
+	}
+}
+
+func TestSaveLoad_Encrypted(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	var buf bytes.Buffer
+	if err := snapshotSource().Save(&buf, SnapshotOptions{Key: key}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("a@x.io")) {
+		t.Fatal("Encrypted snapshot contains plaintext")
+	}
+	snapshot := buf.Bytes()
+
+	if err := New().Load(bytes.NewReader(snapshot), SnapshotOptions{}); !errors.Is(err, ErrSnapshotKey) {
+		t.Errorf("Expected ErrSnapshotKey without key, got %v", err)
+	}
+	wrong := bytes.Repeat([]byte{8}, 32)
+	if err := New().Load(bytes.NewReader(snapshot), SnapshotOptions{Key: wrong}); !errors.Is(err, ErrSnapshotKey) {
+		t.Errorf("Expected ErrSnapshotKey with wrong key, got %v", err)
+	}
+
+	dst := New()
+	if err := dst.Load(bytes.NewReader(snapshot), SnapshotOptions{Key: key}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if dst.Len() != 2 || dst.First().Data["email"] != "a@x.io" {
+		t.Errorf("Unexpected decrypted rows: %+v", dst.First().Data)
+	}
+}
+
+func TestSaveLoad_Errors(t *testing.T) {
+	if err := New().Save(&bytes.Buffer{}, SnapshotOptions{Key: []byte("short")}); err == nil {
+		t.Error("Expected error for invalid key size")
+	}
+	if err := New().Load(bytes.NewReader([]byte("nope")), SnapshotOptions{}); err == nil {
+		t.Error("Expected error for non-snapshot input")
+	}
+
+	var huge bytes.Buffer
+	huge.WriteString(snapshotMagic)
+	huge.WriteByte(snapshotPlain)
+	if err := gob.NewEncoder(&huge).Encode(1 << 62); err != nil {
+		t.Fatal(err)
+	}
+	if err := New().Load(&huge, SnapshotOptions{}); err == nil {
+		t.Error("Expected error for truncated snapshot with a huge row count")
+	}
+}
@@ -0,0 +1,86 @@
+package linkedlist
+
+// MarkDeleted marks the rows matching pred as deleted without unlinking
+// them and returns the number newly marked. Marked rows stay in the list,
+// and are seen by its other methods, until Vacuum removes them; Restore
+// unmarks them. Use Compacted for a list without them. Lists with spilled
+// rows return ErrSpilled.
+func (ll *LinkedList) MarkDeleted(pred func(*Node) bool) (int, error) {
+	return ll.setDeleted(pred, true)
+}
+
+// Restore clears the deleted mark of the marked rows matching pred and
+// returns the number restored.
+func (ll *LinkedList) Restore(pred func(*Node) bool) (int, error) {
+	return ll.setDeleted(func(n *Node) bool { return n.deleted && pred(n) }, false)
+}
+
+// setDeleted sets the mark of the rows matching pred that lack it.
+func (ll *LinkedList) setDeleted(pred func(*Node) bool, mark bool) (int, error) {
+	if ll == nil {
+		return 0, nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return 0, err
+	}
+	if err := ll.requireResident(); err != nil {
+		return 0, err
+	}
+	changed := 0
+	for n := ll.front(); n != nil; n = n.succ() {
+		if n.deleted != mark && pred(n) {
+			n.deleted = mark
+			changed++
+		}
+	}
+	if changed > 0 {
+		op := "MarkDeleted"
+		if !mark {
+			op = "Restore"
+		}
+		ll.record("update", changed, op)
+	}
+	return changed, nil
+}
+
+// Deleted reports whether the node is marked deleted, see MarkDeleted.
+func (n *Node) Deleted() bool {
+	return n.deleted
+}
+
+// Compacted returns a new list with the rows not marked deleted. Like
+// Filter, it shares Data with ll until either side changes it.
+func (ll *LinkedList) Compacted() *LinkedList {
+	return ll.Filter(func(n *Node) bool { return !n.deleted })
+}
+
+// Vacuum unlinks the rows marked deleted and returns how many were removed.
+// Lists with spilled rows return ErrSpilled.
+func (ll *LinkedList) Vacuum() (int, error) {
+	if ll == nil {
+		return 0, nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return 0, err
+	}
+	if err := ll.requireResident(); err != nil {
+		return 0, err
+	}
+	removed := 0
+	var prev *Node
+	for n := ll.head; n != nil; {
+		next := n.next
+		if n.deleted {
+			ll.unlink(prev, n)
+			removed++
+		} else {
+			prev = n
+		}
+		n = next
+	}
+	if removed > 0 {
+		ll.mods++
+		ll.record("update", removed, "Vacuum")
+	}
+	return removed, nil
+}
@@ -0,0 +1,61 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSoftDelete(t *testing.T) {
+	ll := keyedList(1, 2, 3, 4)
+	even := func(n *Node) bool { return n.Data["id"].(int)%2 == 0 }
+
+	marked, err := ll.MarkDeleted(even)
+	if err != nil || marked != 2 {
+		t.Fatalf("Expected 2 rows marked, got %d, %v", marked, err)
+	}
+	if marked, _ := ll.MarkDeleted(even); marked != 0 {
+		t.Errorf("Expected marking again to change nothing, got %d", marked)
+	}
+	if ll.Len() != 4 || !ll.head.next.Deleted() || ll.head.Deleted() {
+		t.Errorf("Expected marked rows to stay linked, got %d rows", ll.Len())
+	}
+	if got := columnValues(ll.Compacted(), "id"); !reflect.DeepEqual(got, []interface{}{1, 3}) {
+		t.Errorf("Expected Compacted to give [1 3], got %v", got)
+	}
+
+	restored, err := ll.Restore(func(n *Node) bool { return n.Data["id"] == 2 })
+	if err != nil || restored != 1 {
+		t.Fatalf("Expected 1 row restored, got %d, %v", restored, err)
+	}
+	removed, err := ll.Vacuum()
+	if err != nil || removed != 1 {
+		t.Fatalf("Expected 1 row vacuumed, got %d, %v", removed, err)
+	}
+	if got := columnValues(ll, "id"); !reflect.DeepEqual(got, []interface{}{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after Vacuum, got %v", got)
+	}
+	if ll.tail.Data["id"] != 3 {
+		t.Errorf("Expected tail 3 after Vacuum, got %v", ll.tail.Data["id"])
+	}
+}
+
+func TestSoftDeleteFrozen(t *testing.T) {
+	ll := keyedList(1, 2)
+	view := ll.Freeze()
+	if _, err := view.MarkDeleted(func(*Node) bool { return true }); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+	if _, err := ll.MarkDeleted(func(*Node) bool { return true }); err != nil {
+		t.Fatalf("MarkDeleted failed: %v", err)
+	}
+	if view.head.Deleted() {
+		t.Error("Expected marking the list to leave an earlier view alone")
+	}
+	later := ll.Freeze()
+	if later.Compacted().Len() != 0 {
+		t.Error("Expected a later view to keep the marks")
+	}
+	if _, err := view.Vacuum(); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen from Vacuum, got %v", err)
+	}
+}
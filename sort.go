@@ -0,0 +1,164 @@
+package linkedlist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sort reorders the list in place so that less reports the nodes in
+// ascending order. The sort is stable and invalidates a running iteration.
+// Like OrderBy, Sort returns ErrFrozen on a frozen list and ErrSpilled on a
+// list with spilled rows, leaving the order unchanged.
+func (ll *LinkedList) Sort(less func(a, b *Node) bool) error {
+	if ll == nil {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+	if ll.len < 2 {
+		return nil
+	}
+
+	nodes := make([]*Node, 0, ll.len)
+	for n := ll.front(); n != nil; n = n.succ() {
+		nodes = append(nodes, n)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return less(nodes[i], nodes[j])
+	})
+	ll.relink(nodes)
+	return nil
+}
+
+// relink rebuilds the chain from nodes in order. It invalidates a running
+// iteration; the iterator restarts at the head after ResetIterator.
+func (ll *LinkedList) relink(nodes []*Node) {
+	ll.head, ll.tail = nil, nil
+	for i, n := range nodes {
+		if i == 0 {
+			ll.head = n
+		} else {
+			nodes[i-1].next = n
+		}
+		ll.tail = n
+	}
+	if ll.tail != nil {
+		ll.tail.next = nil
+	}
+	ll.len = len(nodes)
+	ll.current = ll.head
+	ll.mods++
+}
+
+// orderKey is one column of an ORDER BY clause.
+type orderKey struct {
+	column string
+	desc   bool
+}
+
+// parseOrderBy parses "col [ASC|DESC], ..." into keys.
+func parseOrderBy(spec string) ([]orderKey, error) {
+	var keys []orderKey
+	for _, part := range strings.Split(spec, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("invalid order term %q", strings.TrimSpace(part))
+		}
+		key := orderKey{column: fields[0]}
+		if len(fields) == 2 {
+			switch strings.ToUpper(fields[1]) {
+			case "ASC":
+			case "DESC":
+				key.desc = true
+			default:
+				return nil, fmt.Errorf("invalid sort direction %q", fields[1])
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// OrderBy sorts the list in place by a SQL-like specification such as
+// "created_at DESC, name ASC". Numbers, strings, times and booleans compare
+// naturally and NULL or missing values sort last in either direction. An
+// error is returned if a column holds values that cannot be compared, in
+// which case the order of the list is unchanged. Lists with spilled rows
+// cannot be sorted and return ErrSpilled.
+func (ll *LinkedList) OrderBy(spec string) error {
+	keys, err := parseOrderBy(spec)
+	if err != nil {
+		return err
+	}
+	if ll == nil {
+		return nil
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+	if ll.len < 2 {
+		return nil
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+
+	nodes := make([]*Node, 0, ll.len)
+	for n := ll.front(); n != nil; n = n.succ() {
+		nodes = append(nodes, n)
+	}
+
+	var cmpErr error
+	sort.SliceStable(nodes, func(i, j int) bool {
+		c, err := compareOrder(keys, nodes[i].Data, nodes[j].Data)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return c < 0
+	})
+	if cmpErr != nil {
+		return cmpErr
+	}
+
+	ll.relink(nodes)
+	return nil
+}
+
+// compareOrder compares two rows by keys, returning 0 when every key ties.
+func compareOrder(keys []orderKey, x, y map[string]interface{}) (int, error) {
+	for _, key := range keys {
+		a, _ := lookupColumn(x, key.column)
+		b, _ := lookupColumn(y, key.column)
+		c, err := compareNullsLast(a, b, key.desc)
+		if err != nil {
+			return 0, fmt.Errorf("error ordering by %s: %w", key.column, err)
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareNullsLast compares a and b for sorting, placing nil after every
+// other value and inverting non-nil comparisons when desc is set.
+func compareNullsLast(a, b interface{}, desc bool) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil:
+		return 1, nil
+	case b == nil:
+		return -1, nil
+	}
+	c, err := compareValues(a, b)
+	if desc {
+		c = -c
+	}
+	return c, err
+}
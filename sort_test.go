@@ -0,0 +1,115 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSort(t *testing.T) {
+	ll := New()
+	for _, id := range []int{3, 1, 2} {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+	if err := ll.Sort(lessByID); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+
+	ll.ResetIterator()
+	for want := 1; want <= 3; want++ {
+		node := ll.Next()
+		if node == nil || node.Data["ID"] != want {
+			t.Fatalf("Expected ID %d, got %+v", want, node)
+		}
+	}
+	if ll.Last().Data["ID"] != 3 || ll.Last().next != nil {
+		t.Error("Tail not updated after Sort")
+	}
+}
+
+func TestSort_Refused(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+	for _, id := range []int{5, 4, 3, 2, 1} {
+		ll.Append(map[string]interface{}{"ID": id})
+	}
+	if err := ll.Sort(lessByID); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled, got %v", err)
+	}
+	if ll.Len() != 5 || ll.First().Data["ID"] != 5 {
+		t.Errorf("Expected the list unchanged, got %d rows starting at %v", ll.Len(), ll.First().Data)
+	}
+	if err := New().Freeze().Sort(lessByID); err != ErrFrozen {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestOrderBy_MultipleKeys(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "b", "created_at": day(1)})
+	ll.Append(map[string]interface{}{"name": "a", "created_at": day(2)})
+	ll.Append(map[string]interface{}{"name": "c", "created_at": nil})
+	ll.Append(map[string]interface{}{"name": "a", "created_at": day(1)})
+
+	if err := ll.OrderBy("created_at DESC, name ASC"); err != nil {
+		t.Fatalf("OrderBy failed: %v", err)
+	}
+
+	got := names(ll)
+	want := []string{"a", "a", "b", "c"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, got)
+		}
+	}
+	if !ll.First().Data["created_at"].(time.Time).Equal(day(2)) {
+		t.Errorf("Expected newest row first, got %v", ll.First().Data)
+	}
+	if ll.Last().Data["created_at"] != nil {
+		t.Errorf("Expected NULL row last, got %v", ll.Last().Data)
+	}
+}
+
+func TestOrderBy_MixedNumericTypes(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "x", "n": 2.5})
+	ll.Append(map[string]interface{}{"name": "y", "n": int64(1)})
+	ll.Append(map[string]interface{}{"name": "z", "n": 3})
+
+	if err := ll.OrderBy("n"); err != nil {
+		t.Fatalf("OrderBy failed: %v", err)
+	}
+	got := names(ll)
+	if got[0] != "y" || got[1] != "x" || got[2] != "z" {
+		t.Errorf("Unexpected order: %v", got)
+	}
+}
+
+func TestOrderBy_LargeIntegers(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "y", "n": int64(1<<53 + 1)})
+	ll.Append(map[string]interface{}{"name": "x", "n": int64(1 << 53)})
+
+	if err := ll.OrderBy("n"); err != nil {
+		t.Fatalf("OrderBy failed: %v", err)
+	}
+	if got := names(ll); got[0] != "x" || got[1] != "y" {
+		t.Errorf("Expected integers above 2^53 to order exactly, got %v", got)
+	}
+}
+
+func TestOrderBy_Errors(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"name": "x", "v": 1})
+	ll.Append(map[string]interface{}{"name": "y", "v": "one"})
+
+	if err := ll.OrderBy("v"); err == nil {
+		t.Error("Expected error ordering mixed types")
+	}
+	if got := names(ll); got[0] != "x" || got[1] != "y" {
+		t.Errorf("Expected order unchanged after error, got %v", got)
+	}
+	if err := ll.OrderBy("v SIDEWAYS"); err == nil {
+		t.Error("Expected error for invalid direction")
+	}
+}
@@ -0,0 +1,256 @@
+package linkedlist
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ErrSpilled is returned by methods that change rows in place when some of
+// the list's rows are spilled to disk.
+var ErrSpilled = errors.New("linkedlist: list has spilled rows")
+
+func init() {
+	gob.Register(time.Time{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
+
+// nodeRecord is the serialised form of a node, used for spill files and
+// snapshots.
+type nodeRecord struct {
+	Data      map[string]interface{}
+	Timestamp time.Time
+	RowNum    int
+	Source    string
+}
+
+// spillStore holds the oldest rows of a list in an append-only gob file.
+type spillStore struct {
+	dir         string
+	maxInMemory int
+
+	file *os.File
+	enc  *gob.Encoder
+
+	// reader state for the list iterator
+	reader  *os.File
+	dec     *gob.Decoder
+	pos     int
+	discard int
+
+	count int
+	err   error
+}
+
+// WithSpill keeps at most maxRowsInMemory nodes resident. Older nodes are
+// moved to a temporary file in dir (the system temp dir if empty). Methods
+// that read rows, such as First, Next, Filter, Where and the exporters,
+// still see spilled rows in order, and Len counts them. Spilled rows are
+// read-only: the nodes returned for them are copies, and Set on them panics
+// with ErrFrozen. Methods that change rows in place, such as Sort, OrderBy,
+// AddColumn, CoerceColumn, FillNulls, Rank, Shift, ApplyChanges and Vacuum,
+// return ErrSpilled once any row is spilled. Call Close to remove the spill
+// file.
+func WithSpill(dir string, maxRowsInMemory int) Option {
+	return func(ll *LinkedList) {
+		if maxRowsInMemory > 0 {
+			ll.spill = &spillStore{dir: dir, maxInMemory: maxRowsInMemory}
+		}
+	}
+}
+
+// spillHead moves the head node to the spill file. On failure the node stays
+// in memory and the error is kept for SpillErr.
+func (ll *LinkedList) spillHead() {
+	s := ll.spill
+	if s.err != nil {
+		return
+	}
+	if s.file == nil {
+		f, err := os.CreateTemp(s.dir, "linkedlist-spill-*")
+		if err != nil {
+			s.err = fmt.Errorf("failed to create spill file: %w", err)
+			return
+		}
+		s.file = f
+		s.enc = gob.NewEncoder(f)
+	}
+
+	head := ll.head
+	if err := head.expand(); err != nil {
+		s.err = err
+		return
+	}
+	rec := nodeRecord{Data: head.Data, Timestamp: head.timestamp, RowNum: head.rowNum, Source: head.source}
+	if err := s.enc.Encode(&rec); err != nil {
+		s.err = fmt.Errorf("failed to spill row: %w", err)
+		return
+	}
+
+	// If the iterator has already passed the head, the reader must skip
+	// the record instead of returning it a second time.
+	if ll.current != head && s.pos == s.count {
+		s.pos++
+		s.discard++
+	}
+	s.count++
+	ll.unlink(nil, head)
+}
+
+// spilled returns the number of rows on disk.
+func (s *spillStore) spilled() int {
+	if s == nil {
+		return 0
+	}
+	return s.count
+}
+
+// rewind restarts the spill iterator.
+func (s *spillStore) rewind() {
+	if s == nil {
+		return
+	}
+	if s.reader != nil {
+		s.reader.Close()
+	}
+	s.reader, s.dec = nil, nil
+	s.pos, s.discard = 0, 0
+}
+
+// next decodes the next spilled row, or returns nil once all were read.
+func (s *spillStore) next() *Node {
+	if s == nil || s.pos >= s.count || s.err != nil {
+		return nil
+	}
+	if s.dec == nil {
+		r, err := os.Open(s.file.Name())
+		if err != nil {
+			s.err = fmt.Errorf("failed to open spill file: %w", err)
+			return nil
+		}
+		s.reader, s.dec = r, gob.NewDecoder(r)
+	}
+	for ; s.discard > 0; s.discard-- {
+		if err := s.dec.Decode(&nodeRecord{}); err != nil {
+			s.err = fmt.Errorf("failed to read spill file: %w", err)
+			return nil
+		}
+	}
+	n, err := decodeNodeRecord(s.dec)
+	if err != nil {
+		s.err = err
+		return nil
+	}
+	s.pos++
+	n.frozen = true
+	return n
+}
+
+// first decodes the oldest spilled row without moving the iterator.
+func (s *spillStore) first() *Node {
+	if s.spilled() == 0 || s.err != nil {
+		return nil
+	}
+	r, err := os.Open(s.file.Name())
+	if err != nil {
+		s.err = fmt.Errorf("failed to open spill file: %w", err)
+		return nil
+	}
+	defer r.Close()
+	n, err := decodeNodeRecord(gob.NewDecoder(r))
+	if err != nil {
+		s.err = err
+		return nil
+	}
+	n.frozen = true
+	return n
+}
+
+// forEach calls fn for every spilled row using its own reader. Read errors
+// are also kept for SpillErr.
+func (s *spillStore) forEach(fn func(*Node) error) error {
+	if s.spilled() == 0 {
+		return nil
+	}
+	if s.err != nil {
+		return s.err
+	}
+	r, err := os.Open(s.file.Name())
+	if err != nil {
+		s.err = fmt.Errorf("failed to open spill file: %w", err)
+		return s.err
+	}
+	defer r.Close()
+
+	dec := gob.NewDecoder(r)
+	for i := 0; i < s.count; i++ {
+		n, err := decodeNodeRecord(dec)
+		if err != nil {
+			s.err = err
+			return err
+		}
+		n.frozen = true
+		if err := fn(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeNodeRecord reads one record from dec as a detached node.
+func decodeNodeRecord(dec *gob.Decoder) (*Node, error) {
+	var rec nodeRecord
+	if err := dec.Decode(&rec); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("failed to read spill file: %w", err)
+	}
+	return &Node{Data: rec.Data, timestamp: rec.Timestamp, rowNum: rec.RowNum, source: rec.Source}, nil
+}
+
+// requireResident returns ErrSpilled if any row is spilled.
+func (ll *LinkedList) requireResident() error {
+	if ll.Spilled() > 0 {
+		return ErrSpilled
+	}
+	return nil
+}
+
+// Spilled returns the number of rows currently held on disk.
+func (ll *LinkedList) Spilled() int {
+	if ll == nil {
+		return 0
+	}
+	return ll.spill.spilled()
+}
+
+// SpillErr returns the first error encountered while spilling or reading
+// spilled rows.
+func (ll *LinkedList) SpillErr() error {
+	if ll == nil || ll.spill == nil {
+		return nil
+	}
+	return ll.spill.err
+}
+
+// Close releases resources held by the list, removing any spill file.
+func (ll *LinkedList) Close() error {
+	if ll == nil || ll.spill == nil || ll.spill.file == nil {
+		return nil
+	}
+	s := ll.spill
+	s.rewind()
+	name := s.file.Name()
+	err := s.file.Close()
+	if rmErr := os.Remove(name); err == nil {
+		err = rmErr
+	}
+	s.file, s.enc = nil, nil
+	s.count = 0
+	return err
+}
@@ -0,0 +1,197 @@
+package linkedlist
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWithSpill_IterationIncludesSpilledRows(t *testing.T) {
+	dir := t.TempDir()
+	ll := New(WithSpill(dir, 2))
+	defer ll.Close()
+
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for id := 1; id <= 5; id++ {
+		ll.Append(map[string]interface{}{"ID": id, "at": at, "note": nil})
+	}
+	if err := ll.SpillErr(); err != nil {
+		t.Fatalf("unexpected spill error: %v", err)
+	}
+	if ll.Len() != 5 || ll.Spilled() != 3 {
+		t.Errorf("Expected Len 5 with 3 spilled, got %d and %d", ll.Len(), ll.Spilled())
+	}
+	if ll.First().Data["ID"] != 1 || ll.Last().Data["ID"] != 5 {
+		t.Errorf("Unexpected bounds: %v, %v", ll.First().Data, ll.Last().Data)
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		ll.ResetIterator()
+		want := 1
+		for node := ll.Next(); node != nil; node = ll.Next() {
+			if node.Data["ID"] != want {
+				t.Fatalf("Pass %d: expected ID %d, got %v", pass, want, node.Data["ID"])
+			}
+			if !node.Data["at"].(time.Time).Equal(at) {
+				t.Errorf("Expected time to survive spilling, got %v", node.Data["at"])
+			}
+			want++
+		}
+		if want != 6 {
+			t.Errorf("Pass %d: iterated %d rows, want 5", pass, want-1)
+		}
+	}
+
+	type Row struct{ ID int }
+	var rows []Row
+	if err := ll.ToSlice(&rows); err != nil || len(rows) != 5 {
+		t.Errorf("Expected 5 rows from ToSlice, got %d (err=%v)", len(rows), err)
+	}
+}
+
+func TestWithSpill_AppendDuringIteration(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+
+	collect := func(appendAfter int, data map[string]interface{}) []int {
+		var ids []int
+		for node := ll.Next(); node != nil; node = ll.Next() {
+			ids = append(ids, node.Data["ID"].(int))
+			if len(ids) == appendAfter {
+				ll.Append(data)
+			}
+		}
+		return ids
+	}
+
+	// The head is spilled after the iterator has already returned it.
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.Append(map[string]interface{}{"ID": 2})
+	ll.ResetIterator()
+	if ids := collect(1, map[string]interface{}{"ID": 3}); len(ids) != 3 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("Expected ids [1 2 3], got %v", ids)
+	}
+
+	// The head is spilled before the iterator reaches it.
+	ll.ResetIterator()
+	if ids := collect(1, map[string]interface{}{"ID": 4}); len(ids) != 4 || ids[1] != 2 || ids[3] != 4 {
+		t.Errorf("Expected ids [1 2 3 4], got %v", ids)
+	}
+}
+
+func TestClose_RemovesSpillFile(t *testing.T) {
+	dir := t.TempDir()
+	ll := New(WithSpill(dir, 1))
+	ll.Append(map[string]interface{}{"ID": 1})
+	ll.Append(map[string]interface{}{"ID": 2})
+
+	if err := ll.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("Expected spill file removed, found %d entries", len(entries))
+	}
+	if ll.Len() != 1 {
+		t.Errorf("Expected only resident row after Close, got %d", ll.Len())
+	}
+}
+
+func TestWithSpill_ReadersIncludeSpilledRows(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+	for id := 1; id <= 5; id++ {
+		ll.Append(map[string]interface{}{"ID": id, "tags": []interface{}{"a", "b"}})
+	}
+
+	if got := ll.Filter(func(n *Node) bool { return n.Data["ID"].(int) < 3 }); got.Len() != 2 {
+		t.Errorf("Expected Filter to see spilled rows, got %d rows", got.Len())
+	}
+	if got, err := ll.Where("ID >= 1"); err != nil || got.Len() != 5 {
+		t.Errorf("Expected Where to return 5 rows, got %v, %v", got, err)
+	}
+	if left, right := ll.SplitAfter(func(n *Node) bool { return n.Data["ID"] == 2 }); left.Len() != 2 || right.Len() != 3 {
+		t.Errorf("Expected a 2/3 split, got %d/%d", left.Len(), right.Len())
+	}
+	if got, err := ll.Explode("tags"); err != nil || got.Len() != 10 {
+		t.Errorf("Expected 10 exploded rows, got %v, %v", got, err)
+	}
+	if got := ll.Partition("ID", 0); got.Len() != 5 {
+		t.Errorf("Expected 5 partitioned rows, got %d", got.Len())
+	}
+	report, err := Reconcile(ll, ll, "ID", nil)
+	if err != nil || report.Matched.Len() != 5 {
+		t.Errorf("Expected 5 matched rows, got %v, %v", report, err)
+	}
+	if ll.EstimatedSize() <= ll.residentSize() {
+		t.Error("Expected EstimatedSize to count spilled rows")
+	}
+	if got := ll.Select("ID"); got.Len() != 5 {
+		t.Errorf("Expected Select to return 5 rows, got %d", got.Len())
+	}
+	if got := ll.Nodes(); len(got) != 5 || got[0].Data["ID"] != 1 {
+		t.Errorf("Expected Nodes to start with the spilled row 1, got %d nodes", len(got))
+	}
+	if got, err := ll.Aggregate().Count().Sum("ID").Run(); err != nil || got.First().Data["count"] != int64(5) || got.First().Data["sum_ID"] != int64(15) {
+		t.Errorf("Expected count 5 and sum 15, got %+v, %v", got, err)
+	}
+	other := New()
+	for id := 1; id <= 4; id++ {
+		other.Append(map[string]interface{}{"ID": id, "tags": []interface{}{"a", "b"}})
+	}
+	other.Append(map[string]interface{}{"ID": 6, "tags": []interface{}{"a", "b"}})
+	if Equal(ll, other) {
+		t.Error("Expected Equal to compare spilled rows")
+	}
+	if got, err := ll.Histogram("ID", 1); err != nil || got.First().Data["count"] != int64(5) {
+		t.Errorf("Expected one bin of 5 rows, got %+v, %v", got, err)
+	}
+}
+
+func TestWithSpill_MutatorsRejectSpilledRows(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 1))
+	defer ll.Close()
+	ll.Append(map[string]interface{}{"ID": 2})
+	ll.Append(map[string]interface{}{"ID": 1})
+
+	if err := ll.OrderBy("ID"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from OrderBy, got %v", err)
+	}
+	if _, err := ll.MarkDeleted(func(*Node) bool { return true }); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from MarkDeleted, got %v", err)
+	}
+	if _, err := ll.Vacuum(); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from Vacuum, got %v", err)
+	}
+	if err := ll.ApplyChanges(New(), "ID", "op"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from ApplyChanges, got %v", err)
+	}
+	if err := ll.Sort(lessByID); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from Sort, got %v", err)
+	}
+	if err := ll.AddColumn("x", 1); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from AddColumn, got %v", err)
+	}
+	if err := ll.DropColumn("ID"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from DropColumn, got %v", err)
+	}
+	if err := ll.FillNulls("ID", FillConstant(0)); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from FillNulls, got %v", err)
+	}
+	if err := ll.Rank("ID"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from Rank, got %v", err)
+	}
+	if err := ll.Shift("ID", 1, "prev"); err != ErrSpilled {
+		t.Errorf("Expected ErrSpilled from Shift, got %v", err)
+	}
+	if ll.Last().Data["x"] != nil {
+		t.Error("Expected resident rows to be left unchanged")
+	}
+
+	defer func() {
+		if r := recover(); r != ErrFrozen {
+			t.Errorf("Expected Set on a spilled row to panic with ErrFrozen, got %v", r)
+		}
+	}()
+	ll.First().Set("ID", 3)
+}
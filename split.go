@@ -0,0 +1,48 @@
+package linkedlist
+
+// SplitAt returns the rows before n and the rows from n on as two new lists.
+// The list is left unchanged and the halves share Data with it until either
+// side changes them. If n is not in the list, left holds every row and
+// right is empty. Nodes of spilled rows are copies and never match; use
+// SplitAfter to split at one.
+func (ll *LinkedList) SplitAt(n *Node) (left, right *LinkedList) {
+	found := false
+	left, right = ll.split(func(cur *Node) bool {
+		found = found || cur == n
+		return found
+	})
+	ll.recordDerived(left, "SplitAt left")
+	ll.recordDerived(right, "SplitAt right")
+	return left, right
+}
+
+// SplitAfter returns the rows up to and including the first row matching
+// pred and the rows after it as two new lists, like SplitAt. If no row
+// matches, left holds every row and right is empty.
+func (ll *LinkedList) SplitAfter(pred func(*Node) bool) (left, right *LinkedList) {
+	found, matched := false, false
+	left, right = ll.split(func(cur *Node) bool {
+		found = found || matched
+		matched = matched || pred(cur)
+		return found
+	})
+	ll.recordDerived(left, "SplitAfter left")
+	ll.recordDerived(right, "SplitAfter right")
+	return left, right
+}
+
+// split copies each row into left until inRight first reports true, and
+// every row from then on into right. Spilled rows are included; read errors
+// are reported by SpillErr.
+func (ll *LinkedList) split(inRight func(*Node) bool) (left, right *LinkedList) {
+	left, right = New(), New()
+	ll.each(func(n *Node) error {
+		if inRight(n) {
+			right.appendNode(n.shareCopy())
+		} else {
+			left.appendNode(n.shareCopy())
+		}
+		return nil
+	})
+	return left, right
+}
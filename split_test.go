@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitAt(t *testing.T) {
+	ll := keyedList(1, 2, 3, 4, 5)
+	at := ll.head.next.next
+	left, right := ll.SplitAt(at)
+
+	if got := columnValues(left, "id"); !reflect.DeepEqual(got, []interface{}{1, 2}) {
+		t.Errorf("Expected left [1 2], got %v", got)
+	}
+	if got := columnValues(right, "id"); !reflect.DeepEqual(got, []interface{}{3, 4, 5}) {
+		t.Errorf("Expected right [3 4 5], got %v", got)
+	}
+	if left.Len() != 2 || right.Len() != 3 || ll.Len() != 5 {
+		t.Errorf("Expected lengths 2, 3 and 5, got %d, %d and %d", left.Len(), right.Len(), ll.Len())
+	}
+	if left.tail.Data["id"] != 2 || left.tail.next != nil || right.tail.Data["id"] != 5 {
+		t.Errorf("Expected correct tails, got %v and %v", left.tail.Data, right.tail.Data)
+	}
+
+	left.Append(map[string]interface{}{"id": 9})
+	right.head.Set("id", 30)
+	if got := columnValues(ll, "id"); !reflect.DeepEqual(got, []interface{}{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected the halves to be independent, source became %v", got)
+	}
+	if got := columnValues(right, "id"); !reflect.DeepEqual(got, []interface{}{30, 4, 5}) {
+		t.Errorf("Expected appending to left not to touch right, got %v", got)
+	}
+}
+
+func TestSplitAtEdges(t *testing.T) {
+	ll := keyedList(1, 2)
+	left, right := ll.SplitAt(ll.head)
+	if left.Len() != 0 || right.Len() != 2 {
+		t.Errorf("Expected split at the head to give 0 and 2 rows, got %d and %d", left.Len(), right.Len())
+	}
+	left, right = ll.SplitAt(&Node{})
+	if left.Len() != 2 || right.Len() != 0 {
+		t.Errorf("Expected a foreign node to give 2 and 0 rows, got %d and %d", left.Len(), right.Len())
+	}
+	var empty *LinkedList
+	left, right = empty.SplitAt(nil)
+	if left.Len() != 0 || right.Len() != 0 {
+		t.Error("Expected empty halves from a nil list")
+	}
+}
+
+func TestSplitAfter(t *testing.T) {
+	ll := keyedList(1, 2, 3, 4)
+	left, right := ll.SplitAfter(func(n *Node) bool { return n.Data["id"].(int) >= 2 })
+	if got := columnValues(left, "id"); !reflect.DeepEqual(got, []interface{}{1, 2}) {
+		t.Errorf("Expected left [1 2], got %v", got)
+	}
+	if got := columnValues(right, "id"); !reflect.DeepEqual(got, []interface{}{3, 4}) {
+		t.Errorf("Expected right [3 4], got %v", got)
+	}
+
+	left, right = ll.SplitAfter(func(*Node) bool { return false })
+	if left.Len() != 4 || right.Len() != 0 {
+		t.Errorf("Expected no match to give 4 and 0 rows, got %d and %d", left.Len(), right.Len())
+	}
+}
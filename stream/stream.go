@@ -0,0 +1,129 @@
+// Package stream appends JSON messages from a message stream, such as a
+// Kafka topic, to a bounded linked list.
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	linkedlist "github.com/ifanwar/go-linkedlist"
+)
+
+// MessageSource delivers message payloads. Receive blocks until a message is
+// available and returns io.EOF once the source is exhausted. Adapters for
+// Kafka or other brokers implement it around their consumer client.
+type MessageSource interface {
+	Receive(ctx context.Context) ([]byte, error)
+}
+
+// Consumer reads JSON object messages from a MessageSource into a buffer of
+// at most maxLen rows. When the buffer is full the consumer stops receiving
+// until Drain makes room, so a slow reader slows the stream down instead of
+// losing rows.
+type Consumer struct {
+	src    MessageSource
+	maxLen int
+
+	mu    sync.Mutex
+	buf   *linkedlist.LinkedList
+	space chan struct{} // signalled by Drain
+}
+
+// NewConsumer returns a consumer buffering at most maxLen rows from src.
+func NewConsumer(src MessageSource, maxLen int) *Consumer {
+	if maxLen <= 0 {
+		maxLen = 1
+	}
+	return &Consumer{
+		src:    src,
+		maxLen: maxLen,
+		buf:    linkedlist.New(),
+		space:  make(chan struct{}, 1),
+	}
+}
+
+// Run receives messages until the source returns io.EOF, in which case Run
+// returns nil, ctx is done, or a message fails to decode.
+func (c *Consumer) Run(ctx context.Context) error {
+	for count := 1; ; count++ {
+		if err := c.waitForSpace(ctx); err != nil {
+			return err
+		}
+		payload, err := c.src.Receive(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row, err := decodeRow(payload)
+		if err != nil {
+			return fmt.Errorf("failed to decode message %d: %w", count, err)
+		}
+		c.mu.Lock()
+		c.buf.Append(row)
+		c.mu.Unlock()
+	}
+}
+
+// waitForSpace blocks while the buffer is full.
+func (c *Consumer) waitForSpace(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		full := c.buf.Len() >= c.maxLen
+		c.mu.Unlock()
+		if !full {
+			return nil
+		}
+		select {
+		case <-c.space:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Drain removes and returns the buffered rows, oldest first, as a list with
+// the usual scanning API.
+func (c *Consumer) Drain() *linkedlist.LinkedList {
+	c.mu.Lock()
+	rows := c.buf
+	c.buf = linkedlist.New()
+	c.mu.Unlock()
+
+	select {
+	case c.space <- struct{}{}:
+	default:
+	}
+	return rows
+}
+
+// Len returns the number of buffered rows.
+func (c *Consumer) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.buf.Len()
+}
+
+// decodeRow decodes a JSON object. Integral numbers become int64 and other
+// numbers float64, matching what SQL drivers produce.
+func decodeRow(payload []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var row map[string]interface{}
+	if err := dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, errors.New("message is not a JSON object")
+	}
+	if _, err := linkedlist.DecodeJSONNumbers(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+)
+
+type sliceSource struct {
+	msgs     []string
+	received chan struct{}
+}
+
+func (s *sliceSource) Receive(ctx context.Context) ([]byte, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	if s.received != nil {
+		s.received <- struct{}{}
+	}
+	return []byte(msg), nil
+}
+
+func TestConsumer_RunAndDrain(t *testing.T) {
+	src := &sliceSource{msgs: []string{
+		`{"id": 1, "name": "Alice", "score": 9.5}`,
+		`{"id": 2, "name": "Bob", "tags": [1, 2]}`,
+	}}
+	c := NewConsumer(src, 10)
+	if err := c.Run(context.Background()); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	rows := c.Drain()
+	type event struct {
+		ID    int64
+		Name  string
+		Score float64
+	}
+	var events []event
+	if err := rows.ToSlice(&events); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	if len(events) != 2 || events[0].ID != 1 || events[0].Score != 9.5 || events[1].Name != "Bob" {
+		t.Errorf("Unexpected events: %+v", events)
+	}
+	if tags := rows.Last().Data["tags"].([]interface{}); tags[0] != int64(1) {
+		t.Errorf("Expected nested numbers as int64, got %T", tags[0])
+	}
+	if c.Len() != 0 {
+		t.Errorf("Expected empty buffer after Drain, got %d", c.Len())
+	}
+}
+
+func TestConsumer_Backpressure(t *testing.T) {
+	src := &sliceSource{msgs: []string{`{"n": 1}`, `{"n": 2}`, `{"n": 3}`}, received: make(chan struct{}, 3)}
+	c := NewConsumer(src, 2)
+	done := make(chan error)
+	go func() { done <- c.Run(context.Background()) }()
+
+	<-src.received
+	<-src.received
+	select {
+	case <-src.received:
+		t.Fatal("Expected consumer to stop receiving while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if got := c.Drain().Len(); got != 2 {
+		t.Errorf("Expected 2 drained rows, got %d", got)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got := c.Drain().Len(); got != 1 {
+		t.Errorf("Expected 1 remaining row, got %d", got)
+	}
+}
+
+func TestConsumer_DecodeError(t *testing.T) {
+	c := NewConsumer(&sliceSource{msgs: []string{`{"ok": true}`, `[1, 2]`}}, 10)
+	if err := c.Run(context.Background()); err == nil {
+		t.Error("Expected error for a non-object message")
+	}
+}
+
+func TestConsumer_Cancel(t *testing.T) {
+	c := NewConsumer(&sliceSource{msgs: []string{`{}`, `{}`}}, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.buf.Append(map[string]interface{}{})
+	if err := c.Run(ctx); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
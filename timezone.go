@@ -0,0 +1,93 @@
+package linkedlist
+
+import "time"
+
+// zonelessLayouts are the wall-clock formats drivers commonly return for
+// timestamp columns without a zone.
+var zonelessLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// AssumeUTC treats time values as wall-clock times in UTC, for drivers that
+// return timestamp columns without a zone. See AssumeZone.
+func AssumeUTC() Option {
+	return AssumeZone(time.UTC)
+}
+
+// AssumeZone treats time values as wall-clock times in loc. Loaded time.Time
+// values keep their clock reading but move to loc, and zoneless strings
+// scanned into time.Time fields are parsed in loc. Strings carrying an
+// offset keep it.
+func AssumeZone(loc *time.Location) Option {
+	return func(ll *LinkedList) {
+		ll.assumeLoc = loc
+	}
+}
+
+// ConvertTo presents loaded and scanned time values in loc. It applies after
+// AssumeZone and does not change the instant a value denotes.
+func ConvertTo(loc *time.Location) Option {
+	return func(ll *LinkedList) {
+		ll.convertLoc = loc
+	}
+}
+
+// normalizeTimes applies the list's zone options to the time.Time values of
+// a freshly loaded row.
+func (ll *LinkedList) normalizeTimes(data map[string]interface{}) {
+	if ll.assumeLoc == nil && ll.convertLoc == nil {
+		return
+	}
+	for k, v := range data {
+		if t, ok := v.(time.Time); ok {
+			data[k] = convertTime(assumeZone(t, ll.assumeLoc), ll.convertLoc)
+		}
+	}
+}
+
+// assumeZone returns the time with the same wall clock as t in loc.
+func assumeZone(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return t
+	}
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, mo, d, h, mi, s, t.Nanosecond(), loc)
+}
+
+// convertTime returns t in loc, or t if loc is nil.
+func convertTime(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return t
+	}
+	return t.In(loc)
+}
+
+// scanTime converts a time.Time or string value for a time.Time field.
+// Zoneless strings are accepted only when the scanner assumes a zone.
+func (s *scanner) scanTime(v interface{}) (time.Time, bool) {
+	var assume, convert *time.Location
+	if s != nil {
+		assume, convert = s.assumeLoc, s.convertLoc
+	}
+	switch v := v.(type) {
+	case time.Time:
+		// Loaded values already had AssumeZone applied.
+		return convertTime(v, convert), true
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return convertTime(t, convert), true
+		}
+		if assume == nil {
+			return time.Time{}, false
+		}
+		for _, layout := range zonelessLayouts {
+			if t, err := time.ParseInLocation(layout, v, assume); err == nil {
+				return convertTime(t, convert), true
+			}
+		}
+	}
+	return time.Time{}, false
+}
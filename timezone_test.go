@@ -0,0 +1,84 @@
+package linkedlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssumeUTCOnLoad(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	wall := time.Date(2024, 3, 1, 9, 30, 0, 0, berlin)
+	rows := queryRows(t, []string{"at"}, []interface{}{wall})
+
+	ll := New(AssumeUTC())
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	got := ll.First().Data["at"].(time.Time)
+	want := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	if !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestConvertToOnLoad(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	at := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	rows := queryRows(t, []string{"at"}, []interface{}{at})
+
+	ll := New(ConvertTo(tokyo))
+	if err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	got := ll.First().Data["at"].(time.Time)
+	if !got.Equal(at) {
+		t.Errorf("Expected instant %v, got %v", at, got)
+	}
+	if got.Hour() != 18 {
+		t.Errorf("Expected hour 18 in JST, got %d", got.Hour())
+	}
+}
+
+func TestScanZonelessString(t *testing.T) {
+	type row struct {
+		At time.Time `db:"at"`
+	}
+	tokyo := time.FixedZone("JST", 9*3600)
+	ll := New(AssumeUTC(), ConvertTo(tokyo))
+	ll.Append(map[string]interface{}{"at": "2024-03-01 09:30:00.5"})
+
+	var dest row
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	want := time.Date(2024, 3, 1, 9, 30, 0, 500000000, time.UTC)
+	if !dest.At.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, dest.At)
+	}
+	if dest.At.Location() != tokyo {
+		t.Errorf("Expected location JST, got %v", dest.At.Location())
+	}
+
+	// Without a zone to assume, zoneless strings are not times.
+	plain := New()
+	plain.Append(map[string]interface{}{"at": "2024-03-01 09:30:00"})
+	if err := plain.ScanFirst(&dest); err == nil {
+		t.Error("Expected error scanning zoneless string without AssumeUTC, got nil")
+	}
+}
+
+func TestScanOffsetStringKeepsOffset(t *testing.T) {
+	ll := New(AssumeUTC())
+	ll.Append(map[string]interface{}{"at": "2024-03-01T09:30:00+02:00"})
+
+	var dest struct {
+		At time.Time `db:"at"`
+	}
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	want := time.Date(2024, 3, 1, 7, 30, 0, 0, time.UTC)
+	if !dest.At.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, dest.At)
+	}
+}
@@ -0,0 +1,76 @@
+package linkedlist
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Tracer starts spans around load and export operations. It mirrors the
+// subset of go.opentelemetry.io/otel/trace the list needs, so an OpenTelemetry
+// tracer can be plugged in with a small adapter.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is an operation started by a Tracer.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// WithTracer traces LoadFromSQLxContext, QueryContext and ToSliceContext with
+// t. Spans are named "linkedlist.<Method>" and carry a "linkedlist.rows"
+// attribute with the number of rows processed.
+func WithTracer(t Tracer) Option {
+	return func(ll *LinkedList) {
+		ll.tracer = t
+	}
+}
+
+// noopSpan is used when no tracer is configured.
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) RecordError(error)                {}
+func (noopSpan) End()                             {}
+
+// startSpan starts a span named "linkedlist."+name if the list has a tracer.
+func (ll *LinkedList) startSpan(ctx context.Context, name string) (context.Context, Span) {
+	if ll == nil || ll.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	return ll.tracer.Start(ctx, "linkedlist."+name)
+}
+
+// endSpan records the outcome of an operation on span and ends it.
+func endSpan(span Span, rows int, err error) {
+	span.SetAttribute("linkedlist.rows", rows)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// QueryContext runs query on db and appends the resulting rows to the list.
+func (ll *LinkedList) QueryContext(ctx context.Context, db sqlx.QueryerContext, query string, args ...interface{}) error {
+	return ll.QueryWithOptions(ctx, db, query, LoadOptions{}, args...)
+}
+
+// ToSliceContext is ToSlice with a context for tracing and cancellation.
+func (ll *LinkedList) ToSliceContext(ctx context.Context, destSlice interface{}) (err error) {
+	_, span := ll.startSpan(ctx, "ToSlice")
+	defer func() {
+		rows := 0
+		if v := reflect.ValueOf(destSlice); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Slice {
+			rows = v.Elem().Len()
+		}
+		endSpan(span, rows, err)
+	}()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return ll.ToSlice(destSlice)
+}
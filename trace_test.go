@@ -0,0 +1,86 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *recordedSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *recordedSpan) RecordError(err error)                      { s.err = err }
+func (s *recordedSpan) End()                                       { s.ended = true }
+
+type recordingTracer struct{ spans []*recordedSpan }
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &recordedSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+func TestTracer_LoadAndToSlice(t *testing.T) {
+	tracer := &recordingTracer{}
+	ll := New(WithTracer(tracer))
+	rows := queryRows(t, []string{"id"}, []interface{}{1}, []interface{}{2})
+	if err := ll.LoadFromSQLxContext(context.Background(), rows, LoadOptions{Source: "items"}); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	var out []struct{ ID int }
+	if err := ll.ToSliceContext(context.Background(), &out); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(tracer.spans))
+	}
+	load, export := tracer.spans[0], tracer.spans[1]
+	if load.name != "linkedlist.LoadFromSQLx" || load.attrs["linkedlist.rows"] != 2 || load.attrs["linkedlist.source"] != "items" || !load.ended {
+		t.Errorf("Unexpected load span: %+v", load)
+	}
+	if export.name != "linkedlist.ToSlice" || export.attrs["linkedlist.rows"] != 2 || !export.ended {
+		t.Errorf("Unexpected export span: %+v", export)
+	}
+}
+
+func TestTracer_QueryContextError(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	defer sqlDB.Close()
+	boom := errors.New("boom")
+	mock.ExpectQuery("SELECT").WillReturnError(boom)
+
+	tracer := &recordingTracer{}
+	ll := New(WithTracer(tracer))
+	err = ll.QueryContext(context.Background(), sqlx.NewDb(sqlDB, "sqlmock"), "SELECT id FROM t")
+	if !errors.Is(err, boom) {
+		t.Fatalf("Expected query error, got %v", err)
+	}
+	if len(tracer.spans) != 1 || !errors.Is(tracer.spans[0].err, boom) || !tracer.spans[0].ended {
+		t.Errorf("Expected the error recorded on an ended span, got %+v", tracer.spans)
+	}
+}
+
+func TestLoadFromSQLxContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ll := New()
+	err := ll.LoadFromSQLxContext(ctx, queryRows(t, []string{"id"}, []interface{}{1}), LoadOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if ll.Len() != 0 {
+		t.Errorf("Expected no rows loaded, got %d", ll.Len())
+	}
+}
@@ -0,0 +1,122 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// RowTransformer rewrites a loaded row in place.
+type RowTransformer func(row map[string]interface{}) error
+
+// transformers holds transformers registered with RegisterTransformer.
+var transformers struct {
+	sync.RWMutex
+	m map[string]RowTransformer
+}
+
+// ErrUnknownTransformer is returned when LoadOptions.Transformers names a
+// transformer that was never registered.
+var ErrUnknownTransformer = errors.New("linkedlist: unknown transformer")
+
+func init() {
+	RegisterTransformer("trim", StringTransformer(strings.TrimSpace))
+	RegisterTransformer("valid_utf8", StringTransformer(func(s string) string {
+		return strings.ToValidUTF8(s, "\uFFFD")
+	}))
+	RegisterTransformer("strip_control", StringTransformer(stripControl))
+	RegisterTransformer("normalize_spaces", StringTransformer(normalizeSpaces))
+}
+
+// RegisterTransformer registers fn under name for LoadOptions.Transformers.
+// The package registers:
+//
+//	trim              remove leading and trailing white space
+//	valid_utf8        replace invalid UTF-8 with U+FFFD
+//	strip_control     remove control characters other than \t, \n and \r
+//	normalize_spaces  turn Unicode spaces into ' ' and drop zero-width
+//	                  characters and byte order marks
+//
+// Unicode normalization forms need golang.org/x/text; register one with
+// StringTransformer(norm.NFC.String). Passing a nil fn removes the
+// transformer.
+func RegisterTransformer(name string, fn RowTransformer) {
+	transformers.Lock()
+	defer transformers.Unlock()
+	if fn == nil {
+		delete(transformers.m, name)
+		return
+	}
+	if transformers.m == nil {
+		transformers.m = make(map[string]RowTransformer)
+	}
+	transformers.m[name] = fn
+}
+
+// StringTransformer returns a RowTransformer applying fn to every string
+// value of a row.
+func StringTransformer(fn func(string) string) RowTransformer {
+	return func(row map[string]interface{}) error {
+		for k, v := range row {
+			if s, ok := v.(string); ok {
+				row[k] = fn(s)
+			}
+		}
+		return nil
+	}
+}
+
+// lookupTransformers resolves names to registered transformers.
+func lookupTransformers(names []string) ([]RowTransformer, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	transformers.RLock()
+	defer transformers.RUnlock()
+	fns := make([]RowTransformer, len(names))
+	for i, name := range names {
+		fn := transformers.m[name]
+		if fn == nil {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownTransformer, name)
+		}
+		fns[i] = fn
+	}
+	return fns, nil
+}
+
+// applyTransformers runs fns over row in order.
+func applyTransformers(row map[string]interface{}, fns []RowTransformer) error {
+	for _, fn := range fns {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stripControl removes control characters except tabs and line breaks.
+func stripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// normalizeSpaces replaces Unicode space separators with ' ' and removes
+// zero-width characters and byte order marks.
+func normalizeSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff':
+			return -1
+		}
+		if unicode.Is(unicode.Zs, r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
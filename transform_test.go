@@ -0,0 +1,64 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadTransformers(t *testing.T) {
+	rows := queryRows(t, []string{"name", "note", "n"},
+		[]interface{}{"\u00a0 Ann\u2003Lee\u200b ", "bell\x07 tab\tend", int64(3)})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{Transformers: []string{"normalize_spaces", "strip_control", "trim"}})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := map[string]interface{}{"name": "Ann Lee", "note": "bell tab\tend", "n": int64(3)}
+	if !reflect.DeepEqual(ll.head.Data, want) {
+		t.Errorf("Expected %q, got %q", want, ll.head.Data)
+	}
+}
+
+func TestRegisterTransformer(t *testing.T) {
+	RegisterTransformer("upper", StringTransformer(strings.ToUpper))
+	defer RegisterTransformer("upper", nil)
+	RegisterTransformer("reject", func(row map[string]interface{}) error {
+		if row["name"] == "BAD" {
+			return errors.New("rejected row")
+		}
+		return nil
+	})
+	defer RegisterTransformer("reject", nil)
+
+	rows := queryRows(t, []string{"name"}, []interface{}{"ok"}, []interface{}{"bad"})
+	ll := New()
+	err := ll.LoadFromSQLxWithOptions(rows, LoadOptions{Transformers: []string{"upper", "reject"}})
+	if err == nil || !strings.Contains(err.Error(), "rejected row") {
+		t.Fatalf("Expected transformer error, got %v", err)
+	}
+	if ll.Len() != 1 || ll.head.Data["name"] != "OK" {
+		t.Errorf("Expected the first row upper-cased, got %v", columnValues(ll, "name"))
+	}
+
+	rows = queryRows(t, []string{"name"}, []interface{}{"x"})
+	err = New().LoadFromSQLxWithOptions(rows, LoadOptions{Transformers: []string{"missing"}})
+	if !errors.Is(err, ErrUnknownTransformer) {
+		t.Errorf("Expected ErrUnknownTransformer, got %v", err)
+	}
+}
+
+func TestValidUTF8Transformer(t *testing.T) {
+	row := map[string]interface{}{"s": "a\xffb"}
+	fns, err := lookupTransformers([]string{"valid_utf8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := applyTransformers(row, fns); err != nil {
+		t.Fatal(err)
+	}
+	if row["s"] != "a\uFFFDb" {
+		t.Errorf("Expected invalid bytes replaced, got %q", row["s"])
+	}
+}
@@ -0,0 +1,43 @@
+package linkedlist
+
+// AssembleTree nests child rows under their parent rows. For every parent,
+// the children whose childFK value equals the parent's parentKey value are
+// stored, in order, as a []map[string]interface{} under fieldName in the
+// parent's Data; parents without children get an empty slice. Keys match by
+// value, so int and int64 keys pair up. The resulting rows can be scanned
+// into structs with a []Child field.
+func AssembleTree(parents, children *LinkedList, parentKey, childFK, fieldName string) error {
+	if parents == nil {
+		return nil
+	}
+	if err := parents.beginMutation(); err != nil {
+		return err
+	}
+	if err := parents.requireResident(); err != nil {
+		return err
+	}
+
+	byParent := make(map[string][]map[string]interface{})
+	err := children.each(func(n *Node) error {
+		fk, ok := lookupColumn(n.Data, childFK)
+		if !ok || fk == nil {
+			return nil
+		}
+		key := encodeKey([]interface{}{fk})
+		byParent[key] = append(byParent[key], n.Data)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for n := parents.front(); n != nil; n = n.succ() {
+		pk, _ := lookupColumn(n.Data, parentKey)
+		kids := byParent[encodeKey([]interface{}{pk})]
+		if pk == nil || kids == nil {
+			kids = []map[string]interface{}{}
+		}
+		n.Set(fieldName, kids)
+	}
+	return nil
+}
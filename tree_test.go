@@ -0,0 +1,54 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAssembleTree(t *testing.T) {
+	type Item struct {
+		SKU string `db:"sku"`
+	}
+	type Order struct {
+		ID    int    `db:"id"`
+		Items []Item `db:"items"`
+	}
+
+	orders := New()
+	orders.Append(map[string]interface{}{"id": int64(1)})
+	orders.Append(map[string]interface{}{"id": int64(2)})
+	orders.Append(map[string]interface{}{"id": nil})
+
+	items := New()
+	items.Append(map[string]interface{}{"order_id": 1, "sku": "A"})
+	items.Append(map[string]interface{}{"order_id": 1, "sku": "B"})
+	items.Append(map[string]interface{}{"order_id": 9, "sku": "orphan"})
+	items.Append(map[string]interface{}{"order_id": nil, "sku": "none"})
+
+	if err := AssembleTree(orders, items, "id", "order_id", "items"); err != nil {
+		t.Fatalf("AssembleTree failed: %v", err)
+	}
+
+	var got []Order
+	if err := orders.ToSlice(&got); err != nil {
+		t.Fatalf("ToSlice failed: %v", err)
+	}
+	if len(got[0].Items) != 2 || got[0].Items[0].SKU != "A" || got[0].Items[1].SKU != "B" {
+		t.Errorf("Unexpected children for order 1: %+v", got[0].Items)
+	}
+	if got[1].Items == nil || len(got[1].Items) != 0 {
+		t.Errorf("Expected empty children for order 2, got %+v", got[1].Items)
+	}
+	if kids := orders.Last().Data["items"].([]map[string]interface{}); len(kids) != 0 {
+		t.Errorf("Expected NULL parent key to get no children, got %v", kids)
+	}
+}
+
+func TestAssembleTree_Frozen(t *testing.T) {
+	parents := New()
+	parents.Append(map[string]interface{}{"id": 1})
+	view := parents.Freeze()
+	if err := AssembleTree(view, New(), "id", "parent_id", "kids"); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package linkedlist
+
+import (
+	"errors"
+	"maps"
+)
+
+// ErrTxnDone is returned when a transaction is used after Commit or
+// Rollback.
+var ErrTxnDone = errors.New("linkedlist: transaction already committed or rolled back")
+
+// Txn stages edits to a list that Commit applies all at once. Staged edits
+// are not visible in the list, and the list is left untouched if Commit
+// fails. A Txn is not safe for concurrent use.
+type Txn struct {
+	ll   *LinkedList
+	ops  []txnOp
+	done bool
+}
+
+// txnOp is one staged edit.
+type txnOp struct {
+	kind  string // "append", "remove" or "update"
+	data  map[string]interface{}
+	match func(*Node) bool
+}
+
+// txnRow is a row of the list as the transaction sees it during Commit.
+type txnRow struct {
+	node    *Node // nil for appended rows
+	data    map[string]interface{}
+	changed bool
+}
+
+// Begin starts a transaction on the list.
+func (ll *LinkedList) Begin() *Txn {
+	return &Txn{ll: ll}
+}
+
+// Append stages a new row at the end of the list.
+func (tx *Txn) Append(data map[string]interface{}) error {
+	return tx.stage(txnOp{kind: "append", data: data})
+}
+
+// Remove stages removing every row match reports true for, including rows
+// appended earlier in the transaction.
+func (tx *Txn) Remove(match func(*Node) bool) error {
+	return tx.stage(txnOp{kind: "remove", match: match})
+}
+
+// Update stages setting the columns in set on every row match reports true
+// for.
+func (tx *Txn) Update(match func(*Node) bool, set map[string]interface{}) error {
+	return tx.stage(txnOp{kind: "update", match: match, data: set})
+}
+
+func (tx *Txn) stage(op txnOp) error {
+	if tx.done {
+		return ErrTxnDone
+	}
+	tx.ops = append(tx.ops, op)
+	return nil
+}
+
+// Rollback discards the staged edits.
+func (tx *Txn) Rollback() error {
+	if tx.done {
+		return ErrTxnDone
+	}
+	tx.done = true
+	tx.ops = nil
+	return nil
+}
+
+// Commit applies the staged edits in order. Matches are evaluated at Commit
+// against the rows as the earlier edits left them, so a later Update sees
+// rows appended before it. Either every edit is applied or, when the list
+// is frozen or has spilled rows, none is and Commit returns ErrFrozen or
+// ErrSpilled.
+func (tx *Txn) Commit() error {
+	if tx.done {
+		return ErrTxnDone
+	}
+	ll := tx.ll
+	if ll == nil {
+		return ErrNilList
+	}
+	if err := ll.beginMutation(); err != nil {
+		return err
+	}
+	if err := ll.requireResident(); err != nil {
+		return err
+	}
+	tx.done = true
+
+	rows := make([]txnRow, 0, ll.len+len(tx.ops))
+	for n := ll.front(); n != nil; n = n.succ() {
+		rows = append(rows, txnRow{node: n, data: n.Data})
+	}
+	view := func(r txnRow) *Node {
+		if r.node == nil {
+			return &Node{Data: r.data}
+		}
+		return r.node.derive(r.data)
+	}
+	for _, op := range tx.ops {
+		switch op.kind {
+		case "append":
+			rows = append(rows, txnRow{data: op.data})
+		case "remove":
+			kept := rows[:0]
+			for _, r := range rows {
+				if !op.match(view(r)) {
+					kept = append(kept, r)
+				}
+			}
+			rows = kept
+		case "update":
+			for i, r := range rows {
+				if !op.match(view(r)) {
+					continue
+				}
+				if !r.changed {
+					r.data = maps.Clone(r.data)
+					if r.data == nil {
+						r.data = make(map[string]interface{}, len(op.data))
+					}
+					r.changed = true
+				}
+				for k, v := range op.data {
+					r.data[k] = v
+				}
+				rows[i] = r
+			}
+		}
+	}
+
+	// Nothing below can fail, so the list changes as a whole.
+	kept := make([]*Node, 0, len(rows))
+	var appended []map[string]interface{}
+	updated := 0
+	for _, r := range rows {
+		if r.node == nil {
+			appended = append(appended, r.data)
+			continue
+		}
+		if r.changed {
+			r.node.Data = r.data
+			r.node.sharedData = false
+			updated++
+		}
+		kept = append(kept, r.node)
+	}
+	removed := ll.len - len(kept)
+	if removed > 0 {
+		ll.relink(kept)
+	}
+	for _, data := range appended {
+		ll.Append(data)
+	}
+	ll.record("update", len(appended)+removed+updated, "Txn commit ops=%d", len(tx.ops))
+	tx.ops = nil
+	return nil
+}
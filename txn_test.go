@@ -0,0 +1,107 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func idIs(id int) func(*Node) bool {
+	return func(n *Node) bool { return n.Data["id"] == id }
+}
+
+func TestTxnCommit(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	ll.Append(map[string]interface{}{"id": 2, "name": "Bob"})
+	first := ll.First()
+
+	tx := ll.Begin()
+	tx.Update(idIs(1), map[string]interface{}{"name": "Alicia"})
+	tx.Remove(idIs(2))
+	tx.Append(map[string]interface{}{"id": 3, "name": "Carol"})
+	tx.Update(idIs(3), map[string]interface{}{"vip": true})
+
+	if ll.Len() != 2 || first.Data["name"] != "Alice" {
+		t.Fatal("Expected staged edits to be invisible before Commit")
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if ll.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", ll.Len())
+	}
+	if ll.First() != first || first.Data["name"] != "Alicia" {
+		t.Errorf("Expected first node updated in place, got %v", ll.First().Data)
+	}
+	if last := ll.Last().Data; last["id"] != 3 || last["vip"] != true {
+		t.Errorf("Expected appended row with vip, got %v", last)
+	}
+	if err := tx.Commit(); !errors.Is(err, ErrTxnDone) {
+		t.Errorf("Expected ErrTxnDone on second Commit, got %v", err)
+	}
+}
+
+func TestTxnRollback(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1})
+
+	tx := ll.Begin()
+	tx.Remove(idIs(1))
+	tx.Append(map[string]interface{}{"id": 2})
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	if ll.Len() != 1 || ll.First().Data["id"] != 1 {
+		t.Errorf("Expected list unchanged after Rollback, got %d rows", ll.Len())
+	}
+	if err := tx.Append(map[string]interface{}{"id": 3}); !errors.Is(err, ErrTxnDone) {
+		t.Errorf("Expected ErrTxnDone after Rollback, got %v", err)
+	}
+}
+
+func TestTxnFrozenAppliesNothing(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1})
+	frozen := ll.Freeze()
+
+	tx := frozen.Begin()
+	tx.Append(map[string]interface{}{"id": 2})
+	if err := tx.Commit(); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Expected ErrFrozen, got %v", err)
+	}
+	if frozen.Len() != 1 {
+		t.Errorf("Expected 1 row, got %d", frozen.Len())
+	}
+}
+
+func TestTxnSpilledAppliesNothing(t *testing.T) {
+	ll := New(WithSpill(t.TempDir(), 2))
+	defer ll.Close()
+	for i := 1; i <= 5; i++ {
+		ll.Append(map[string]interface{}{"id": i})
+	}
+
+	tx := ll.Begin()
+	tx.Remove(func(*Node) bool { return true })
+	if err := tx.Commit(); !errors.Is(err, ErrSpilled) {
+		t.Errorf("Expected ErrSpilled, got %v", err)
+	}
+	if ll.Len() != 5 {
+		t.Errorf("Expected 5 rows, got %d", ll.Len())
+	}
+}
+
+func TestTxnUpdateDoesNotTouchSharedData(t *testing.T) {
+	ll := New()
+	ll.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	view := ll.Freeze()
+
+	tx := ll.Begin()
+	tx.Update(idIs(1), map[string]interface{}{"name": "Alicia"})
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if view.First().Data["name"] != "Alice" {
+		t.Errorf("Expected filtered view unchanged, got %v", view.First().Data["name"])
+	}
+}
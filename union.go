@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UnionOptions controls how Union aligns the rows of its inputs.
+type UnionOptions struct {
+	// Defaults supplies the value for a column missing from a row. Columns
+	// without a default are filled with nil.
+	Defaults map[string]interface{}
+	// ReconcileTypes widens numeric columns to a single type: float64 when
+	// any value in the column is a float, otherwise int64. A column mixing
+	// numbers with other non-nil values is an error.
+	ReconcileTypes bool
+}
+
+// Union returns a new list with the rows of a followed by those of b, every
+// row holding the union of both inputs' columns. The inputs are not modified.
+func Union(a, b *LinkedList, opts UnionOptions) (*LinkedList, error) {
+	columns := make(map[string]bool)
+	var rows []*Node
+	for _, src := range []*LinkedList{a, b} {
+		err := src.each(func(n *Node) error {
+			for k := range n.Data {
+				columns[k] = true
+			}
+			rows = append(rows, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var floats map[string]bool
+	if opts.ReconcileTypes {
+		var err error
+		if floats, err = numericColumns(rows); err != nil {
+			return nil, err
+		}
+	}
+
+	result := New()
+	for _, n := range rows {
+		row := make(map[string]interface{}, len(columns))
+		for c := range columns {
+			v, ok := n.Data[c]
+			if !ok {
+				v = opts.Defaults[c]
+			}
+			if isFloat, numeric := floats[c]; numeric && v != nil {
+				if isFloat {
+					v, _ = toFloat64(v)
+				} else {
+					v, _ = toInt64(v)
+				}
+			}
+			row[c] = v
+		}
+		result.appendNode(n.derive(row))
+	}
+	return result, nil
+}
+
+// numericColumns finds the columns whose non-nil values are all numbers and
+// reports whether each needs widening to float64.
+func numericColumns(rows []*Node) (map[string]bool, error) {
+	numeric := make(map[string]bool)
+	other := make(map[string]reflect.Type)
+	for _, n := range rows {
+		for c, v := range n.Data {
+			if v == nil {
+				continue
+			}
+			_, isInt := toInt64(v)
+			_, isNum := toFloat64(v)
+			switch {
+			case isInt:
+				if _, ok := numeric[c]; !ok {
+					numeric[c] = false
+				}
+			case isNum:
+				numeric[c] = true
+			default:
+				if _, seen := other[c]; !seen {
+					other[c] = reflect.TypeOf(v)
+				}
+			}
+		}
+	}
+	for c, t := range other {
+		if _, ok := numeric[c]; ok {
+			return nil, fmt.Errorf("column %s mixes numbers with %v", c, t)
+		}
+	}
+	return numeric, nil
+}
@@ -0,0 +1,58 @@
+package linkedlist
+
+import "testing"
+
+func TestUnion_AlignsColumns(t *testing.T) {
+	a := New()
+	a.Append(map[string]interface{}{"id": 1, "name": "Alice"})
+	b := New()
+	b.Append(map[string]interface{}{"id": 2, "region": "eu"})
+
+	u, err := Union(a, b, UnionOptions{Defaults: map[string]interface{}{"region": "us"}})
+	if err != nil {
+		t.Fatalf("Union failed: %v", err)
+	}
+	if u.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", u.Len())
+	}
+	first, last := u.First().Data, u.Last().Data
+	if first["region"] != "us" || len(first) != 3 {
+		t.Errorf("Expected default region on first row, got %v", first)
+	}
+	if v, ok := last["name"]; !ok || v != nil {
+		t.Errorf("Expected nil name on second row, got %v", last)
+	}
+	if _, ok := a.First().Data["region"]; ok {
+		t.Error("Expected input to be left unchanged")
+	}
+}
+
+func TestUnion_ReconcileTypes(t *testing.T) {
+	a := New()
+	a.Append(map[string]interface{}{"qty": int32(3), "price": 2})
+	b := New()
+	b.Append(map[string]interface{}{"qty": uint8(4), "price": 2.5})
+
+	u, err := Union(a, b, UnionOptions{ReconcileTypes: true})
+	if err != nil {
+		t.Fatalf("Union failed: %v", err)
+	}
+	if u.First().Data["qty"] != int64(3) || u.Last().Data["qty"] != int64(4) {
+		t.Errorf("Expected qty widened to int64, got %T, %T", u.First().Data["qty"], u.Last().Data["qty"])
+	}
+	if u.First().Data["price"] != float64(2) {
+		t.Errorf("Expected price widened to float64, got %T", u.First().Data["price"])
+	}
+
+	b.Append(map[string]interface{}{"qty": "five"})
+	if _, err := Union(a, b, UnionOptions{ReconcileTypes: true}); err == nil {
+		t.Error("Expected error for a column mixing numbers and strings")
+	}
+}
+
+func TestUnion_NilInputs(t *testing.T) {
+	u, err := Union(nil, peopleList(), UnionOptions{})
+	if err != nil || u.Len() != 4 {
+		t.Errorf("Expected 4 rows, got %d (%v)", u.Len(), err)
+	}
+}
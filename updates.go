@@ -0,0 +1,73 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SQLStatement is a statement with positional "?" placeholders and their
+// arguments. Use sqlx.Rebind to convert the placeholders for other drivers.
+type SQLStatement struct {
+	SQL  string
+	Args []interface{}
+}
+
+// GenerateUpdates compares the list with baseline, row by row on keyColumn,
+// and returns an UPDATE for every row whose values changed, setting only the
+// changed columns in column name order. Rows missing from either side and
+// columns absent from the current row are skipped. Identifiers are quoted
+// with ANSI double quotes.
+func (ll *LinkedList) GenerateUpdates(baseline *LinkedList, table, keyColumn string) ([]SQLStatement, error) {
+	if table == "" || keyColumn == "" {
+		return nil, errors.New("table and key column must not be empty")
+	}
+	base, err := indexUnique(baseline, keyColumn, "baseline")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := indexUnique(ll, keyColumn, "list"); err != nil {
+		return nil, err
+	}
+
+	var stmts []SQLStatement
+	err = ll.each(func(n *Node) error {
+		key := n.Data[keyColumn]
+		old, ok := base[encodeKey([]interface{}{key})]
+		if !ok {
+			return nil
+		}
+		var changed []string
+		for c, v := range n.Data {
+			if c == keyColumn {
+				continue
+			}
+			if ov, ok := old.Data[c]; !ok || !valuesEqual(v, ov) {
+				changed = append(changed, c)
+			}
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		sort.Strings(changed)
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "UPDATE %s SET ", Postgres.QuoteIdent(table))
+		args := make([]interface{}, 0, len(changed)+1)
+		for i, c := range changed {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(Postgres.quoteName(c) + " = ?")
+			args = append(args, n.Data[c])
+		}
+		fmt.Fprintf(&sb, " WHERE %s = ?", Postgres.quoteName(keyColumn))
+		stmts = append(stmts, SQLStatement{SQL: sb.String(), Args: append(args, key)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stmts, nil
+}
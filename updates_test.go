@@ -0,0 +1,43 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateUpdates(t *testing.T) {
+	baseline := New()
+	baseline.Append(map[string]interface{}{"id": 1, "name": "Alice", "age": 34})
+	baseline.Append(map[string]interface{}{"id": 2, "name": "Bob", "age": 28})
+	baseline.Append(map[string]interface{}{"id": 3, "name": "Carol", "age": 41})
+
+	edited := New()
+	edited.Append(map[string]interface{}{"id": 1, "name": "Alice", "age": int64(34)})
+	edited.Append(map[string]interface{}{"id": 2, "name": "Robert", "age": 29})
+	edited.Append(map[string]interface{}{"id": 3, "name": "Carol", "email": nil})
+	edited.Append(map[string]interface{}{"id": 4, "name": "Dan"})
+
+	stmts, err := edited.GenerateUpdates(baseline, "public.users", "id")
+	if err != nil {
+		t.Fatalf("GenerateUpdates failed: %v", err)
+	}
+	want := []SQLStatement{
+		{SQL: `UPDATE "public"."users" SET "age" = ?, "name" = ? WHERE "id" = ?`, Args: []interface{}{29, "Robert", 2}},
+		{SQL: `UPDATE "public"."users" SET "email" = ? WHERE "id" = ?`, Args: []interface{}{nil, 3}},
+	}
+	if !reflect.DeepEqual(stmts, want) {
+		t.Errorf("Unexpected statements:\n got %+v\nwant %+v", stmts, want)
+	}
+}
+
+func TestGenerateUpdates_Errors(t *testing.T) {
+	dup := New()
+	dup.Append(map[string]interface{}{"id": 1})
+	dup.Append(map[string]interface{}{"id": 1})
+	if _, err := New().GenerateUpdates(dup, "t", "id"); err == nil {
+		t.Error("Expected error for duplicate baseline keys")
+	}
+	if _, err := New().GenerateUpdates(New(), "", "id"); err == nil {
+		t.Error("Expected error for empty table")
+	}
+}
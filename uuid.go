@@ -0,0 +1,142 @@
+package linkedlist
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// NormalizeUUID returns the canonical lower-case, hyphenated form of a UUID
+// given as a [16]byte, 16 raw bytes, or text with or without hyphens, braces
+// or a urn:uuid: prefix.
+func NormalizeUUID(v interface{}) (string, error) {
+	b, err := uuidBytes(v)
+	if err != nil {
+		return "", err
+	}
+	return formatUUID(b), nil
+}
+
+// uuidBytes returns the 16 bytes of a UUID value.
+func uuidBytes(v interface{}) ([16]byte, error) {
+	var b [16]byte
+	switch x := v.(type) {
+	case [16]byte:
+		return x, nil
+	case []byte:
+		if len(x) == 16 {
+			copy(b[:], x)
+			return b, nil
+		}
+		return uuidBytes(string(x))
+	case string:
+		if len(x) == 16 {
+			copy(b[:], x)
+			return b, nil
+		}
+		s := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(x)), "urn:uuid:")
+		s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+		s = strings.ReplaceAll(s, "-", "")
+		if len(s) != 32 {
+			return b, fmt.Errorf("invalid UUID %q", x)
+		}
+		if _, err := hex.Decode(b[:], []byte(s)); err != nil {
+			return b, fmt.Errorf("invalid UUID %q", x)
+		}
+		return b, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Array && rv.Len() == 16 && rv.Type().Elem().Kind() == reflect.Uint8 {
+		reflect.Copy(reflect.ValueOf(b[:]), rv)
+		return b, nil
+	}
+	return b, fmt.Errorf("cannot convert %T to a UUID", v)
+}
+
+// formatUUID writes b in the 8-4-4-4-12 form.
+func formatUUID(b [16]byte) string {
+	h := hex.EncodeToString(b[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// swapGUID converts between the byte order SQL Server uses for the first
+// three groups of a UNIQUEIDENTIFIER and RFC 4122 order.
+func swapGUID(b [16]byte) [16]byte {
+	b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
+	b[4], b[5] = b[5], b[4]
+	b[6], b[7] = b[7], b[6]
+	return b
+}
+
+// uuidColumns returns the columns to normalize as UUIDs on load, mapped to
+// whether their binary form is SQL Server's UNIQUEIDENTIFIER order.
+func uuidColumns(names []string, detect bool, types []*sql.ColumnType, loaded []string) map[string]bool {
+	cols := make(map[string]bool)
+	for _, n := range names {
+		cols[n] = false
+	}
+	for i, ct := range types {
+		name := loadedName(ct, i, loaded)
+		switch strings.ToUpper(ct.DatabaseTypeName()) {
+		case "UNIQUEIDENTIFIER":
+			if _, ok := cols[name]; ok || detect {
+				cols[name] = true
+			}
+		case "UUID":
+			if detect {
+				cols[name] = false
+			}
+		}
+	}
+	return cols
+}
+
+// normalizeUUIDColumns replaces the UUID values of a row with their
+// canonical strings.
+func normalizeUUIDColumns(data map[string]interface{}, cols map[string]bool) error {
+	for col, guid := range cols {
+		v := data[col]
+		if v == nil {
+			continue
+		}
+		b, err := uuidBytes(v)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", col, err)
+		}
+		if guid && isRawUUID(v) {
+			b = swapGUID(b)
+		}
+		data[col] = formatUUID(b)
+	}
+	return nil
+}
+
+// isRawUUID reports whether v holds a UUID as 16 bytes rather than text.
+func isRawUUID(v interface{}) bool {
+	switch x := v.(type) {
+	case string:
+		return len(x) == 16
+	case []byte:
+		return len(x) == 16
+	}
+	return true
+}
+
+// setUUIDArray sets [16]byte-backed fields, such as uuid.UUID, from UUID
+// text. It reports false for other fields or values.
+func setUUIDArray(field reflect.Value, fieldType reflect.Type, dataValue interface{}) (bool, error) {
+	if fieldType.Kind() != reflect.Array || fieldType.Len() != 16 || fieldType.Elem().Kind() != reflect.Uint8 {
+		return false, nil
+	}
+	if _, ok := dataValue.(string); !ok {
+		return false, nil
+	}
+	b, err := uuidBytes(dataValue)
+	if err != nil {
+		return true, err
+	}
+	field.Set(reflect.ValueOf(b).Convert(fieldType))
+	return true, nil
+}
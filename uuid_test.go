@@ -0,0 +1,87 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+const testUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+var testUUIDBytes = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+func TestNormalizeUUID(t *testing.T) {
+	inputs := []interface{}{
+		testUUID,
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		testUUIDBytes,
+		testUUIDBytes[:],
+		[]byte(testUUID),
+	}
+	for _, in := range inputs {
+		got, err := NormalizeUUID(in)
+		if err != nil {
+			t.Errorf("NormalizeUUID(%v) failed: %v", in, err)
+			continue
+		}
+		if got != testUUID {
+			t.Errorf("Expected %s for %v, got %s", testUUID, in, got)
+		}
+	}
+	if _, err := NormalizeUUID("not-a-uuid"); err == nil {
+		t.Error("Expected error for invalid UUID, got nil")
+	}
+}
+
+func TestLoadUUIDColumns(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock DB: %v", err)
+	}
+	defer sqlDB.Close()
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+
+	guid := swapGUID(testUUIDBytes)
+	rows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("pg").OfType("UUID", nil),
+		sqlmock.NewColumn("mssql").OfType("UNIQUEIDENTIFIER", nil),
+		sqlmock.NewColumn("mysql").OfType("BINARY", nil),
+	).AddRow("6BA7B810-9DAD-11D1-80B4-00C04FD430C8", guid[:], testUUIDBytes[:])
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+	sqlxRows, err := db.Queryx("SELECT")
+	if err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	defer sqlxRows.Close()
+
+	ll := New()
+	opts := LoadOptions{UUIDColumns: []string{"mysql"}, DetectUUIDs: true}
+	if err := ll.LoadFromSQLxWithOptions(sqlxRows, opts); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	for _, col := range []string{"pg", "mssql", "mysql"} {
+		if got := ll.First().Data[col]; got != testUUID {
+			t.Errorf("Expected %s in %s, got %v", testUUID, col, got)
+		}
+	}
+}
+
+func TestScanUUIDIntoByteArray(t *testing.T) {
+	type uuidType [16]byte
+	ll := New()
+	ll.Append(map[string]interface{}{"id": testUUID})
+
+	var dest struct {
+		ID uuidType `db:"id"`
+	}
+	if err := ll.ScanFirst(&dest); err != nil {
+		t.Fatalf("ScanFirst failed: %v", err)
+	}
+	if dest.ID != uuidType(testUUIDBytes) {
+		t.Errorf("Expected %x, got %x", testUUIDBytes, dest.ID)
+	}
+}
@@ -0,0 +1,44 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCorruptList is wrapped by the errors Validate returns.
+var ErrCorruptList = errors.New("linkedlist: corrupt list")
+
+// Validate walks the resident nodes and checks the list's structure: the
+// chain must be free of cycles, end at the tail, and hold exactly Len nodes
+// (not counting spilled rows). It is a debugging aid for code that splices or
+// removes nodes.
+func (ll *LinkedList) Validate() error {
+	if ll == nil {
+		return nil
+	}
+	if (ll.head == nil) != (ll.tail == nil) {
+		return fmt.Errorf("%w: head is %v but tail is %v", ErrCorruptList, ll.head, ll.tail)
+	}
+
+	// Floyd's cycle detection: the fast pointer laps the slow one on a cycle.
+	for slow, fast := ll.head, ll.head; fast != nil && fast.next != nil; {
+		slow, fast = slow.next, fast.next.next
+		if slow == fast {
+			return fmt.Errorf("%w: cycle detected", ErrCorruptList)
+		}
+	}
+
+	count := 0
+	var last *Node
+	for n := ll.head; n != nil; n = n.next {
+		count++
+		last = n
+	}
+	if last != ll.tail {
+		return fmt.Errorf("%w: tail is not the last node", ErrCorruptList)
+	}
+	if count != ll.len {
+		return fmt.Errorf("%w: length is %d but %d nodes are linked", ErrCorruptList, ll.len, count)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	if err := New().Validate(); err != nil {
+		t.Errorf("Expected empty list to be valid, got %v", err)
+	}
+
+	ll := peopleList()
+	ll.Sort(func(a, b *Node) bool { return a.Data["name"].(string) > b.Data["name"].(string) })
+	if err := ll.Validate(); err != nil {
+		t.Errorf("Expected sorted list to be valid, got %v", err)
+	}
+}
+
+func TestValidate_Corruption(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(ll *LinkedList)
+	}{
+		{"length", func(ll *LinkedList) { ll.len++ }},
+		{"tail", func(ll *LinkedList) { ll.tail = ll.head }},
+		{"cycle", func(ll *LinkedList) { ll.tail.next = ll.head.next }},
+		{"missing tail", func(ll *LinkedList) { ll.tail = nil }},
+	}
+	for _, tt := range tests {
+		ll := peopleList()
+		tt.corrupt(ll)
+		if err := ll.Validate(); !errors.Is(err, ErrCorruptList) {
+			t.Errorf("%s: expected ErrCorruptList, got %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package linkedlist
+
+import "fmt"
+
+// Zip returns a new list pairing the rows of a and b by position, each
+// holding the columns of a's row named prefixA+column and of b's row named
+// prefixB+column. The lists must have the same length, and the prefixes
+// must keep the two sides' names apart.
+func Zip(a, b *LinkedList, prefixA, prefixB string) (*LinkedList, error) {
+	var right []*Node
+	if err := b.each(func(n *Node) error {
+		right = append(right, n)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if a.Len() != len(right) {
+		return nil, fmt.Errorf("cannot zip lists of different lengths %d and %d", a.Len(), len(right))
+	}
+
+	result := New()
+	pos := 0
+	err := a.each(func(n *Node) error {
+		m := right[pos]
+		pos++
+		row := make(map[string]interface{}, len(n.Data)+len(m.Data))
+		for k, v := range n.Data {
+			row[prefixA+k] = v
+		}
+		for k, v := range m.Data {
+			name := prefixB + k
+			if _, dup := row[name]; dup {
+				return fmt.Errorf("row %d: column %q is on both sides", pos, name)
+			}
+			row[name] = v
+		}
+		result.appendNode(n.derive(row))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	values := New()
+	labels := New()
+	for i, l := range []string{"low", "mid", "high"} {
+		values.Append(map[string]interface{}{"id": i, "value": i * 10})
+		labels.Append(map[string]interface{}{"id": i, "label": l})
+	}
+	zipped, err := Zip(values, labels, "v_", "l_")
+	if err != nil {
+		t.Fatalf("Zip failed: %v", err)
+	}
+	if zipped.Len() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", zipped.Len())
+	}
+	want := map[string]interface{}{"v_id": 1, "v_value": 10, "l_id": 1, "l_label": "mid"}
+	if got := zipped.head.next.Data; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestZipErrors(t *testing.T) {
+	a := keyedList(1, 2)
+	if _, err := Zip(a, keyedList(1), "a_", "b_"); err == nil {
+		t.Error("Expected error for lists of different lengths")
+	}
+	if _, err := Zip(a, keyedList(3, 4), "", ""); err == nil {
+		t.Error("Expected error for clashing column names")
+	}
+	zipped, err := Zip(nil, New(), "a_", "b_")
+	if err != nil || zipped.Len() != 0 {
+		t.Errorf("Expected an empty result for empty lists, got %v, %v", zipped.Len(), err)
+	}
+}